@@ -0,0 +1,123 @@
+// Package checkpoint persist pipeline and app state after each completed
+// step, so a run can be resumed from the last completed step
+package checkpoint
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/state"
+)
+
+// Var encoded state var
+type Var struct {
+	Type string `json:"type"`
+	Data []byte `json:"data,omitempty"`
+}
+
+// Checkpoint state vars after Step completed steps. Last is the last step result
+type Checkpoint struct {
+	RunID     string          `json:"run_id"`
+	Step      int             `json:"step"`
+	Vars      map[string]*Var `json:"vars"`
+	Last      *Var            `json:"last,omitempty"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// Store persist checkpoints by run id
+type Store interface {
+	Save(cp *Checkpoint) error
+	Load(runID string) *result.Result[*option.Option[*Checkpoint]]
+	Delete(runID string) error
+}
+
+// Snapshot decoded checkpoint
+type Snapshot struct {
+	RunID string
+	Step  int
+	State *state.State
+	Last  *option.Option[any]
+}
+
+// Checkpointer encode state with a Serializer and persist on a Store
+type Checkpointer struct {
+	store      Store
+	serializer Serializer
+}
+
+// New checkpointer with JSON serializer
+func New(store Store) *Checkpointer {
+	return &Checkpointer{store: store, serializer: Default}
+}
+
+// WithSerializer set state vars serializer
+func (this *Checkpointer) WithSerializer(serializer Serializer) *Checkpointer {
+	this.serializer = serializer
+	return this
+}
+
+// Save state after step completed steps. Vars on skip are not saved, like
+// resources that are open again on resume
+func (this *Checkpointer) Save(runID string, step int, st *state.State, last any, skip ...string) error {
+	cp := &Checkpoint{
+		RunID:     runID,
+		Step:      step,
+		Vars:      map[string]*Var{},
+		UpdatedAt: time.Now(),
+	}
+	for key, val := range st.Items() {
+		if slices.Contains(skip, key) {
+			continue
+		}
+		v, err := this.serializer.Encode(val)
+		if err != nil {
+			return fmt.Errorf("checkpoint var %v: %w", key, err)
+		}
+		cp.Vars[key] = v
+	}
+	if last != nil {
+		v, err := this.serializer.Encode(last)
+		if err != nil {
+			return fmt.Errorf("checkpoint last result: %w", err)
+		}
+		cp.Last = v
+	}
+	return this.store.Save(cp)
+}
+
+// Load last checkpoint of run. Return None if run has no checkpoint
+func (this *Checkpointer) Load(runID string) *result.Result[*option.Option[*Snapshot]] {
+	res := this.store.Load(runID)
+	if res.IsError() {
+		return result.OfError[*option.Option[*Snapshot]](res.Failure())
+	}
+	if res.Get().IsEmpty() {
+		return result.OfValue(option.None[*Snapshot]())
+	}
+
+	cp := res.Get().Get()
+	snapshot := &Snapshot{RunID: cp.RunID, Step: cp.Step, State: state.NewState(), Last: option.None[any]()}
+	for key, v := range cp.Vars {
+		val, err := this.serializer.Decode(v)
+		if err != nil {
+			return result.OfError[*option.Option[*Snapshot]](fmt.Errorf("checkpoint var %v: %w", key, err))
+		}
+		snapshot.State.SetVar(key, val)
+	}
+	if cp.Last != nil {
+		val, err := this.serializer.Decode(cp.Last)
+		if err != nil {
+			return result.OfError[*option.Option[*Snapshot]](fmt.Errorf("checkpoint last result: %w", err))
+		}
+		snapshot.Last = option.Some(val)
+	}
+	return result.OfValue(option.Some(snapshot))
+}
+
+// Delete run checkpoint, after run completed
+func (this *Checkpointer) Delete(runID string) error {
+	return this.store.Delete(runID)
+}
@@ -0,0 +1,103 @@
+package checkpoint
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/mobilemindtech/go-io/codec"
+)
+
+// Serializer encode and decode state vars
+type Serializer interface {
+	Encode(value any) (*Var, error)
+	Decode(v *Var) (any, error)
+}
+
+var (
+	typesMu sync.RWMutex
+	types   = map[string]reflect.Type{}
+)
+
+// Register type of state vars, so values can be decoded by type name.
+// Basic types are registered by default
+func Register[T any]() {
+	typ := reflect.TypeFor[T]()
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	if other, ok := types[typ.String()]; ok && other != typ {
+		panic(fmt.Sprintf("checkpoint type name %v already registered", typ))
+	}
+	types[typ.String()] = typ
+}
+
+func lookupType(name string) (reflect.Type, bool) {
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+	typ, ok := types[name]
+	return typ, ok
+}
+
+func init() {
+	Register[string]()
+	Register[bool]()
+	Register[int]()
+	Register[int32]()
+	Register[int64]()
+	Register[uint]()
+	Register[uint64]()
+	Register[float32]()
+	Register[float64]()
+	Register[[]byte]()
+	Register[[]string]()
+	Register[[]int]()
+	Register[[]int64]()
+	Register[[]float64]()
+	Register[[]any]()
+	Register[map[string]any]()
+	Register[map[string]string]()
+	Register[time.Time]()
+}
+
+// CodecSerializer encode vars with a codec and decode by registered type
+type CodecSerializer struct {
+	codec codec.Codec
+}
+
+func NewCodecSerializer(c codec.Codec) *CodecSerializer {
+	return &CodecSerializer{codec: c}
+}
+
+// Default JSON serializer
+var Default Serializer = NewCodecSerializer(codec.NewJsonCodec())
+
+func (this *CodecSerializer) Encode(value any) (*Var, error) {
+	if value == nil {
+		return &Var{}, nil
+	}
+	typ := reflect.TypeOf(value)
+	if _, ok := lookupType(typ.String()); !ok {
+		return nil, fmt.Errorf("type %v is not registered", typ)
+	}
+	data, err := this.codec.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &Var{Type: typ.String(), Data: data}, nil
+}
+
+func (this *CodecSerializer) Decode(v *Var) (any, error) {
+	if v.Type == "" {
+		return nil, nil
+	}
+	typ, ok := lookupType(v.Type)
+	if !ok {
+		return nil, fmt.Errorf("type %v is not registered", v.Type)
+	}
+	ptr := reflect.New(typ)
+	if err := this.codec.Unmarshal(v.Data, ptr.Interface()); err != nil {
+		return nil, err
+	}
+	return ptr.Elem().Interface(), nil
+}
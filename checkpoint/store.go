@@ -0,0 +1,119 @@
+package checkpoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+)
+
+// MemoryStore keep checkpoints in memory, for tests and runs that can be
+// resumed in the same process
+type MemoryStore struct {
+	mu          sync.Mutex
+	checkpoints map[string][]byte
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{checkpoints: map[string][]byte{}}
+}
+
+// Save keep checkpoint encoded, so later changes on vars don't change it
+func (this *MemoryStore) Save(cp *Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.checkpoints[cp.RunID] = data
+	return nil
+}
+
+func (this *MemoryStore) Load(runID string) *result.Result[*option.Option[*Checkpoint]] {
+	this.mu.Lock()
+	data, ok := this.checkpoints[runID]
+	this.mu.Unlock()
+	if !ok {
+		return result.OfValue(option.None[*Checkpoint]())
+	}
+	return decode(data)
+}
+
+func (this *MemoryStore) Delete(runID string) error {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	delete(this.checkpoints, runID)
+	return nil
+}
+
+// FileStore store checkpoints as JSON files on directory. Files are replaced
+// atomically, so a crash during save keeps the previous checkpoint
+type FileStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{dir: dir}
+}
+
+func (this *FileStore) Save(cp *Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if err := os.MkdirAll(this.dir, 0o755); err != nil {
+		return err
+	}
+	tmp := this.path(cp.RunID) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, this.path(cp.RunID))
+}
+
+func (this *FileStore) Load(runID string) *result.Result[*option.Option[*Checkpoint]] {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	data, err := os.ReadFile(this.path(runID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return result.OfValue(option.None[*Checkpoint]())
+	}
+	if err != nil {
+		return result.OfError[*option.Option[*Checkpoint]](err)
+	}
+	return decode(data)
+}
+
+func (this *FileStore) Delete(runID string) error {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	err := os.Remove(this.path(runID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (this *FileStore) path(runID string) string {
+	sum := sha256.Sum256([]byte(runID))
+	return filepath.Join(this.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func decode(data []byte) *result.Result[*option.Option[*Checkpoint]] {
+	cp := &Checkpoint{}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return result.OfError[*option.Option[*Checkpoint]](err)
+	}
+	return result.OfValue(option.Some(cp))
+}
@@ -0,0 +1,132 @@
+// Command goio render the flow graph of IOApps registered with runtime.Register.
+//
+// The package with the registrations is linked into a generated program that
+// runs inside the current module:
+//
+//	goio list ./checkout
+//	goio graph -format mermaid ./checkout checkout
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"text/template"
+)
+
+var program = template.Must(template.New("main").Parse(`package main
+
+import (
+	"fmt"
+	"os"
+
+	goio "github.com/mobilemindtech/go-io/runtime"
+	_ "{{.}}"
+)
+
+func main() {
+	switch os.Args[1] {
+	case "list":
+		for _, name := range goio.Registered() {
+			fmt.Println(name)
+		}
+	case "graph":
+		if err := goio.Render(os.Stdout, os.Args[2], os.Args[3]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+`))
+
+// errUsage is returned for invalid arguments, main print usage and exit with 2
+var errUsage = errors.New("usage")
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage:")
+	fmt.Fprintln(os.Stderr, "  goio list <package>")
+	fmt.Fprintln(os.Stderr, "  goio graph [-format dot|mermaid] [-o file] <package> <app>")
+}
+
+func main() {
+	if err := goio(os.Args[1:]); errors.Is(err, errUsage) {
+		usage()
+		os.Exit(2)
+	} else if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// goio run command of args. Exit is left to main, so deferred cleanups run
+func goio(args []string) error {
+	if len(args) < 2 {
+		return errUsage
+	}
+
+	var programArgs []string
+	var pkg string
+	output := os.Stdout
+
+	switch args[0] {
+	case "list":
+		pkg = args[1]
+		programArgs = []string{"list"}
+	case "graph":
+		flags := flag.NewFlagSet("graph", flag.ContinueOnError)
+		format := flags.String("format", "dot", "graph format, dot or mermaid")
+		out := flags.String("o", "", "output file, default stdout")
+		if err := flags.Parse(args[1:]); err != nil || flags.NArg() != 2 {
+			return errUsage
+		}
+		pkg = flags.Arg(0)
+		programArgs = []string{"graph", flags.Arg(1), *format}
+		if *out != "" {
+			f, err := os.Create(*out)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			output = f
+		}
+	default:
+		return errUsage
+	}
+
+	return run(pkg, programArgs, output)
+}
+
+// run generate program that import pkg in a temp dir of current module and run it
+func run(pkg string, args []string, output *os.File) error {
+	if !filepath.IsAbs(pkg) && (pkg == "." || pkg[0] == '.') {
+		resolved, err := exec.Command("go", "list", pkg).Output()
+		if err != nil {
+			return fmt.Errorf("package %v not found: %v", pkg, err)
+		}
+		pkg = string(resolved[:len(resolved)-1])
+	}
+
+	dir, err := os.MkdirTemp(".", ".goio-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	f, err := os.Create(filepath.Join(dir, "main.go"))
+	if err != nil {
+		return err
+	}
+	err = program.Execute(f, pkg)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command("go", append([]string{"run", "./" + filepath.Base(dir)}, args...)...)
+	cmd.Stdout = output
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,15 @@
+// Command goiovet report io.IO effect chains whose types don't match, before
+// running them. It can run standalone or as a go vet tool:
+//
+//	goiovet ./...
+//	go vet -vettool=$(which goiovet) ./...
+package main
+
+import (
+	"github.com/mobilemindtech/go-io/flowcheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(flowcheck.Analyzer)
+}
@@ -0,0 +1,144 @@
+package codec
+
+import (
+	"fmt"
+	"mime"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+)
+
+const (
+	MimeJSON    = "application/json"
+	MimeXML     = "application/xml"
+	MimeTextXML = "text/xml"
+	MimeCSV     = "text/csv"
+	MimeForm    = "application/x-www-form-urlencoded"
+	MimeMsgPack = "application/msgpack"
+)
+
+// Codec marshal and unmarshal values of a content type
+type Codec interface {
+	ContentType() string
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+type Registry struct {
+	mu     sync.RWMutex
+	codecs map[string]Codec
+}
+
+func NewRegistry() *Registry {
+	return &Registry{codecs: map[string]Codec{}}
+}
+
+// Register codec by your content type and optional aliases
+func (this *Registry) Register(c Codec, aliases ...string) *Registry {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.codecs[c.ContentType()] = c
+	for _, alias := range aliases {
+		this.codecs[strings.ToLower(alias)] = c
+	}
+	return this
+}
+
+// Lookup codec by content type. Params are ignored and structured
+// suffixes (+json, +xml) resolve to base codec
+func (this *Registry) Lookup(contentType string) *option.Option[Codec] {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return option.None[Codec]()
+	}
+
+	this.mu.RLock()
+	defer this.mu.RUnlock()
+
+	if c, ok := this.codecs[mediaType]; ok {
+		return option.Some(c)
+	}
+
+	if i := strings.LastIndex(mediaType, "+"); i >= 0 {
+		switch mediaType[i+1:] {
+		case "json":
+			return option.Of(this.codecs[MimeJSON])
+		case "xml":
+			return option.Of(this.codecs[MimeXML])
+		}
+	}
+
+	return option.None[Codec]()
+}
+
+// Default registry with JSON, XML, CSV, form and MessagePack codecs
+var Default = NewRegistry().
+	Register(NewJsonCodec()).
+	Register(NewXmlCodec(), MimeTextXML).
+	Register(NewCsvCodec()).
+	Register(NewFormCodec()).
+	Register(NewMsgPackCodec(), "application/x-msgpack")
+
+func Register(c Codec, aliases ...string) *Registry {
+	return Default.Register(c, aliases...)
+}
+
+func Lookup(contentType string) *option.Option[Codec] {
+	return Default.Lookup(contentType)
+}
+
+type Encoder[T any] struct {
+	codec Codec
+}
+
+func NewEncoder[T any](c Codec) *Encoder[T] {
+	return &Encoder[T]{codec: c}
+}
+
+func (this *Encoder[T]) Encode(data T) *result.Result[[]byte] {
+	return result.Try(func() ([]byte, error) {
+		return this.codec.Marshal(data)
+	})
+}
+
+type Decoder[T any] struct {
+	codec Codec
+}
+
+func NewDecoder[T any](c Codec) *Decoder[T] {
+	return &Decoder[T]{codec: c}
+}
+
+func (this *Decoder[T]) Decode(data []byte) *result.Result[T] {
+	return result.Try(func() (T, error) {
+		typOf := reflect.TypeFor[T]()
+		if typOf.Kind() == reflect.Pointer {
+			val := reflect.New(typOf.Elem()).Interface()
+			return val.(T), this.codec.Unmarshal(data, val)
+		} else {
+			var val T
+			return val, this.codec.Unmarshal(data, &val)
+		}
+	})
+}
+
+// EncoderFor encoder of registered content type
+func EncoderFor[T any](contentType string) *result.Result[*Encoder[T]] {
+	return result.Map(lookupResult(contentType), NewEncoder[T])
+}
+
+// DecoderFor decoder of registered content type
+func DecoderFor[T any](contentType string) *result.Result[*Decoder[T]] {
+	return result.Map(lookupResult(contentType), NewDecoder[T])
+}
+
+func lookupResult(contentType string) *result.Result[Codec] {
+	c := Lookup(contentType)
+	if c.IsEmpty() {
+		return result.OfError[Codec](fmt.Errorf("codec not found for content type %v", contentType))
+	}
+	return result.OfValue(c.Get())
+}
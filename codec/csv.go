@@ -0,0 +1,143 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"reflect"
+)
+
+// CsvCodec encode [][]string or slices of structs. The first row is the
+// header, struct columns are named by `csv` tag or field name
+type CsvCodec struct {
+	Comma rune
+}
+
+func NewCsvCodec() *CsvCodec {
+	return &CsvCodec{Comma: ','}
+}
+
+func (this *CsvCodec) ContentType() string {
+	return MimeCSV
+}
+
+func (this *CsvCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Comma = this.Comma
+
+	if records, ok := v.([][]string); ok {
+		if err := w.WriteAll(records); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	rows := indirect(reflect.ValueOf(v))
+	if rows.Kind() != reflect.Slice && rows.Kind() != reflect.Array {
+		return nil, fmt.Errorf("csv: can't encode %T, expected slice", v)
+	}
+
+	elemType := rows.Type().Elem()
+	for elemType.Kind() == reflect.Pointer {
+		elemType = elemType.Elem()
+	}
+
+	if elemType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("csv: can't encode %T, expected slice of struct", v)
+	}
+
+	fields := structFields(elemType, "csv")
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.name
+	}
+
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < rows.Len(); i++ {
+		row := indirect(rows.Index(i))
+		record := make([]string, len(fields))
+		if row.Kind() == reflect.Struct {
+			for j, f := range fields {
+				s, err := formatString(row.Field(f.index))
+				if err != nil {
+					return nil, fmt.Errorf("csv: field %v: %v", f.name, err)
+				}
+				record[j] = s
+			}
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func (this *CsvCodec) Unmarshal(data []byte, v any) error {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = this.Comma
+	records, err := r.ReadAll()
+	if err != nil {
+		return err
+	}
+
+	if ptr, ok := v.(*[][]string); ok {
+		*ptr = records
+		return nil
+	}
+
+	ptr := reflect.ValueOf(v)
+	if ptr.Kind() != reflect.Pointer || ptr.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("csv: can't decode into %T, expected pointer to slice", v)
+	}
+
+	slice := ptr.Elem()
+	elemType := slice.Type().Elem()
+	structType := elemType
+	for structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+
+	if structType.Kind() != reflect.Struct {
+		return fmt.Errorf("csv: can't decode into %T, expected slice of struct", v)
+	}
+
+	result := reflect.MakeSlice(slice.Type(), 0, len(records))
+
+	if len(records) == 0 {
+		slice.Set(result)
+		return nil
+	}
+
+	fields := structFields(structType, "csv")
+	columns := make([]*structField, len(records[0]))
+	for i, name := range records[0] {
+		columns[i] = findField(fields, name)
+	}
+
+	for line, record := range records[1:] {
+		elem := reflect.New(elemType).Elem()
+		row := elem
+		if elemType.Kind() == reflect.Pointer {
+			elem.Set(reflect.New(structType))
+			row = elem.Elem()
+		}
+		for i, raw := range record {
+			if i >= len(columns) || columns[i] == nil || len(raw) == 0 {
+				continue
+			}
+			if err := setString(row.Field(columns[i].index), raw); err != nil {
+				return fmt.Errorf("csv: line %v, column %v: %v", line+2, columns[i].name, err)
+			}
+		}
+		result = reflect.Append(result, elem)
+	}
+
+	slice.Set(result)
+	return nil
+}
@@ -0,0 +1,117 @@
+package codec
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// FormCodec encode url.Values, map[string]string, map[string][]string and
+// structs with fields named by `form` tag or field name
+type FormCodec struct {
+}
+
+func NewFormCodec() *FormCodec {
+	return &FormCodec{}
+}
+
+func (this *FormCodec) ContentType() string {
+	return MimeForm
+}
+
+func (this *FormCodec) Marshal(v any) ([]byte, error) {
+	values := url.Values{}
+
+	switch x := v.(type) {
+	case url.Values:
+		values = x
+	case map[string][]string:
+		values = x
+	case map[string]string:
+		for k, val := range x {
+			values.Set(k, val)
+		}
+	default:
+		st := indirect(reflect.ValueOf(v))
+		if st.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("form: can't encode %T", v)
+		}
+		for _, f := range structFields(st.Type(), "form") {
+			field := st.Field(f.index)
+			if f.omitEmpty && field.IsZero() {
+				continue
+			}
+			if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+				for i := 0; i < field.Len(); i++ {
+					s, err := formatString(field.Index(i))
+					if err != nil {
+						return nil, fmt.Errorf("form: field %v: %v", f.name, err)
+					}
+					values.Add(f.name, s)
+				}
+				continue
+			}
+			s, err := formatString(field)
+			if err != nil {
+				return nil, fmt.Errorf("form: field %v: %v", f.name, err)
+			}
+			values.Set(f.name, s)
+		}
+	}
+
+	return []byte(values.Encode()), nil
+}
+
+func (this *FormCodec) Unmarshal(data []byte, v any) error {
+	values, err := url.ParseQuery(string(data))
+	if err != nil {
+		return err
+	}
+
+	switch x := v.(type) {
+	case *url.Values:
+		*x = values
+		return nil
+	case *map[string][]string:
+		*x = values
+		return nil
+	case *map[string]string:
+		m := map[string]string{}
+		for k := range values {
+			m[k] = values.Get(k)
+		}
+		*x = m
+		return nil
+	}
+
+	ptr := reflect.ValueOf(v)
+	if ptr.Kind() != reflect.Pointer || ptr.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("form: can't decode into %T, expected pointer to struct", v)
+	}
+
+	st := ptr.Elem()
+	fields := structFields(st.Type(), "form")
+
+	for name, vals := range values {
+		f := findField(fields, name)
+		if f == nil || len(vals) == 0 {
+			continue
+		}
+		field := st.Field(f.index)
+		if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+			slice := reflect.MakeSlice(field.Type(), len(vals), len(vals))
+			for i, raw := range vals {
+				if err := setString(slice.Index(i), raw); err != nil {
+					return fmt.Errorf("form: field %v: %v", name, err)
+				}
+			}
+			field.Set(slice)
+			continue
+		}
+		if err := setString(field, vals[0]); err != nil {
+			return fmt.Errorf("form: field %v: %v", name, err)
+		}
+	}
+
+	return nil
+}
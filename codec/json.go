@@ -0,0 +1,22 @@
+package codec
+
+import "encoding/json"
+
+type JsonCodec struct {
+}
+
+func NewJsonCodec() *JsonCodec {
+	return &JsonCodec{}
+}
+
+func (this *JsonCodec) ContentType() string {
+	return MimeJSON
+}
+
+func (this *JsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (this *JsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,595 @@
+package codec
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// MsgPackCodec self-contained MessagePack implementation. Structs are
+// encoded as maps with fields named by `msgpack` tag or field name.
+// Extension types are not supported.
+type MsgPackCodec struct {
+}
+
+func NewMsgPackCodec() *MsgPackCodec {
+	return &MsgPackCodec{}
+}
+
+func (this *MsgPackCodec) ContentType() string {
+	return MimeMsgPack
+}
+
+func (this *MsgPackCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := mpEncode(&buf, reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (this *MsgPackCodec) Unmarshal(data []byte, v any) error {
+	ptr := reflect.ValueOf(v)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() {
+		return fmt.Errorf("msgpack: can't decode into %T, expected non nil pointer", v)
+	}
+	r := &mpReader{data: data}
+	val, err := r.next()
+	if err != nil {
+		return err
+	}
+	if r.pos != len(data) {
+		return fmt.Errorf("msgpack: %v trailing bytes", len(data)-r.pos)
+	}
+	return mpAssign(ptr.Elem(), val)
+}
+
+func MsgPackMarshal(v any) ([]byte, error) {
+	return NewMsgPackCodec().Marshal(v)
+}
+
+func MsgPackUnmarshal(data []byte, v any) error {
+	return NewMsgPackCodec().Unmarshal(data, v)
+}
+
+var textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+
+func mpEncode(buf *bytes.Buffer, v reflect.Value) error {
+
+	if !v.IsValid() {
+		buf.WriteByte(0xc0)
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			buf.WriteByte(0xc0)
+			return nil
+		}
+	}
+
+	if v.Type().Implements(textMarshalerType) && v.Kind() != reflect.Interface {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return err
+		}
+		mpWriteString(buf, string(b))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return mpEncode(buf, v.Elem())
+	case reflect.Bool:
+		if v.Bool() {
+			buf.WriteByte(0xc3)
+		} else {
+			buf.WriteByte(0xc2)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		mpWriteInt(buf, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		mpWriteUint(buf, v.Uint())
+	case reflect.Float32:
+		buf.WriteByte(0xca)
+		binary.Write(buf, binary.BigEndian, math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		buf.WriteByte(0xcb)
+		binary.Write(buf, binary.BigEndian, math.Float64bits(v.Float()))
+	case reflect.String:
+		mpWriteString(buf, v.String())
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			mpWriteBin(buf, b)
+			return nil
+		}
+		mpWriteHeader(buf, v.Len(), 0x90, 16, 0xdc, 0xdd)
+		for i := 0; i < v.Len(); i++ {
+			if err := mpEncode(buf, v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		type entry struct {
+			key []byte
+			val reflect.Value
+		}
+		var entries []entry
+		iter := v.MapRange()
+		for iter.Next() {
+			var kb bytes.Buffer
+			if err := mpEncode(&kb, iter.Key()); err != nil {
+				return err
+			}
+			entries = append(entries, entry{kb.Bytes(), iter.Value()})
+		}
+		// deterministic output
+		sort.Slice(entries, func(i, j int) bool {
+			return bytes.Compare(entries[i].key, entries[j].key) < 0
+		})
+		mpWriteHeader(buf, len(entries), 0x80, 16, 0xde, 0xdf)
+		for _, e := range entries {
+			buf.Write(e.key)
+			if err := mpEncode(buf, e.val); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		var fields []*structField
+		for _, f := range structFields(v.Type(), "msgpack") {
+			if f.omitEmpty && v.Field(f.index).IsZero() {
+				continue
+			}
+			fields = append(fields, f)
+		}
+		mpWriteHeader(buf, len(fields), 0x80, 16, 0xde, 0xdf)
+		for _, f := range fields {
+			mpWriteString(buf, f.name)
+			if err := mpEncode(buf, v.Field(f.index)); err != nil {
+				return fmt.Errorf("msgpack: field %v: %w", f.name, err)
+			}
+		}
+	default:
+		return fmt.Errorf("msgpack: unsupported type %v", v.Type())
+	}
+	return nil
+}
+
+func mpWriteHeader(buf *bytes.Buffer, n int, fix byte, fixMax int, code16 byte, code32 byte) {
+	switch {
+	case n < fixMax:
+		buf.WriteByte(fix | byte(n))
+	case n <= math.MaxUint16:
+		buf.WriteByte(code16)
+		binary.Write(buf, binary.BigEndian, uint16(n))
+	default:
+		buf.WriteByte(code32)
+		binary.Write(buf, binary.BigEndian, uint32(n))
+	}
+}
+
+func mpWriteString(buf *bytes.Buffer, s string) {
+	n := len(s)
+	switch {
+	case n < 32:
+		buf.WriteByte(0xa0 | byte(n))
+	case n <= math.MaxUint8:
+		buf.WriteByte(0xd9)
+		buf.WriteByte(byte(n))
+	case n <= math.MaxUint16:
+		buf.WriteByte(0xda)
+		binary.Write(buf, binary.BigEndian, uint16(n))
+	default:
+		buf.WriteByte(0xdb)
+		binary.Write(buf, binary.BigEndian, uint32(n))
+	}
+	buf.WriteString(s)
+}
+
+func mpWriteBin(buf *bytes.Buffer, b []byte) {
+	n := len(b)
+	switch {
+	case n <= math.MaxUint8:
+		buf.WriteByte(0xc4)
+		buf.WriteByte(byte(n))
+	case n <= math.MaxUint16:
+		buf.WriteByte(0xc5)
+		binary.Write(buf, binary.BigEndian, uint16(n))
+	default:
+		buf.WriteByte(0xc6)
+		binary.Write(buf, binary.BigEndian, uint32(n))
+	}
+	buf.Write(b)
+}
+
+func mpWriteUint(buf *bytes.Buffer, u uint64) {
+	switch {
+	case u <= 0x7f:
+		buf.WriteByte(byte(u))
+	case u <= math.MaxUint8:
+		buf.WriteByte(0xcc)
+		buf.WriteByte(byte(u))
+	case u <= math.MaxUint16:
+		buf.WriteByte(0xcd)
+		binary.Write(buf, binary.BigEndian, uint16(u))
+	case u <= math.MaxUint32:
+		buf.WriteByte(0xce)
+		binary.Write(buf, binary.BigEndian, uint32(u))
+	default:
+		buf.WriteByte(0xcf)
+		binary.Write(buf, binary.BigEndian, u)
+	}
+}
+
+func mpWriteInt(buf *bytes.Buffer, i int64) {
+	switch {
+	case i >= 0:
+		mpWriteUint(buf, uint64(i))
+	case i >= -32:
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt8:
+		buf.WriteByte(0xd0)
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt16:
+		buf.WriteByte(0xd1)
+		binary.Write(buf, binary.BigEndian, int16(i))
+	case i >= math.MinInt32:
+		buf.WriteByte(0xd2)
+		binary.Write(buf, binary.BigEndian, int32(i))
+	default:
+		buf.WriteByte(0xd3)
+		binary.Write(buf, binary.BigEndian, i)
+	}
+}
+
+// mpPair decoded map entry, keep keys of any type
+type mpPair struct {
+	key any
+	val any
+}
+
+type mpReader struct {
+	data []byte
+	pos  int
+}
+
+var errMsgPackShort = errors.New("msgpack: unexpected end of data")
+
+func (this *mpReader) read(n int) ([]byte, error) {
+	if n < 0 || this.pos+n > len(this.data) {
+		return nil, errMsgPackShort
+	}
+	b := this.data[this.pos : this.pos+n]
+	this.pos += n
+	return b, nil
+}
+
+func (this *mpReader) readUint(n int) (uint64, error) {
+	b, err := this.read(n)
+	if err != nil {
+		return 0, err
+	}
+	switch n {
+	case 1:
+		return uint64(b[0]), nil
+	case 2:
+		return uint64(binary.BigEndian.Uint16(b)), nil
+	case 4:
+		return uint64(binary.BigEndian.Uint32(b)), nil
+	default:
+		return binary.BigEndian.Uint64(b), nil
+	}
+}
+
+func (this *mpReader) next() (any, error) {
+	b, err := this.read(1)
+	if err != nil {
+		return nil, err
+	}
+	code := b[0]
+
+	switch {
+	case code <= 0x7f:
+		return int64(code), nil
+	case code >= 0xe0:
+		return int64(int8(code)), nil
+	case code&0xe0 == 0xa0:
+		return this.str(int(code & 0x1f))
+	case code&0xf0 == 0x90:
+		return this.array(int(code & 0x0f))
+	case code&0xf0 == 0x80:
+		return this.mapOf(int(code & 0x0f))
+	}
+
+	switch code {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		return this.readUint(1 << (code - 0xcc))
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (code - 0xd0)
+		u, err := this.readUint(size)
+		if err != nil {
+			return nil, err
+		}
+		switch size {
+		case 1:
+			return int64(int8(u)), nil
+		case 2:
+			return int64(int16(u)), nil
+		case 4:
+			return int64(int32(u)), nil
+		default:
+			return int64(u), nil
+		}
+	case 0xca:
+		u, err := this.readUint(4)
+		return float64(math.Float32frombits(uint32(u))), err
+	case 0xcb:
+		u, err := this.readUint(8)
+		return math.Float64frombits(u), err
+	case 0xd9, 0xda, 0xdb:
+		n, err := this.readUint(1 << (code - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return this.str(int(n))
+	case 0xc4, 0xc5, 0xc6:
+		n, err := this.readUint(1 << (code - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		b, err := this.read(int(n))
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{}, b...), nil
+	case 0xdc, 0xdd:
+		n, err := this.readUint(2 << (code - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return this.array(int(n))
+	case 0xde, 0xdf:
+		n, err := this.readUint(2 << (code - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return this.mapOf(int(n))
+	}
+
+	return nil, fmt.Errorf("msgpack: unsupported code 0x%x at %v", code, this.pos-1)
+}
+
+func (this *mpReader) str(n int) (any, error) {
+	b, err := this.read(n)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (this *mpReader) array(n int) (any, error) {
+	if n > len(this.data)-this.pos {
+		return nil, errMsgPackShort
+	}
+	items := make([]any, n)
+	for i := range items {
+		val, err := this.next()
+		if err != nil {
+			return nil, err
+		}
+		items[i] = val
+	}
+	return items, nil
+}
+
+func (this *mpReader) mapOf(n int) (any, error) {
+	if n > len(this.data)-this.pos {
+		return nil, errMsgPackShort
+	}
+	pairs := make([]mpPair, n)
+	for i := range pairs {
+		key, err := this.next()
+		if err != nil {
+			return nil, err
+		}
+		val, err := this.next()
+		if err != nil {
+			return nil, err
+		}
+		pairs[i] = mpPair{key, val}
+	}
+	return pairs, nil
+}
+
+// mpToAny convert decoded value to plain go values
+func mpToAny(x any) any {
+	switch val := x.(type) {
+	case []any:
+		items := make([]any, len(val))
+		for i, it := range val {
+			items[i] = mpToAny(it)
+		}
+		return items
+	case []mpPair:
+		m := map[string]any{}
+		for _, p := range val {
+			m[fmt.Sprintf("%v", p.key)] = mpToAny(p.val)
+		}
+		return m
+	default:
+		return val
+	}
+}
+
+func mpAssign(v reflect.Value, x any) error {
+
+	if x == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return mpAssign(v.Elem(), x)
+	}
+
+	if s, ok := x.(string); ok && v.CanAddr() && v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	mismatch := func() error {
+		return fmt.Errorf("msgpack: can't assign %T to %v", x, v.Type())
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.NumMethod() != 0 {
+			return mismatch()
+		}
+		v.Set(reflect.ValueOf(mpToAny(x)))
+	case reflect.Bool:
+		b, ok := x.(bool)
+		if !ok {
+			return mismatch()
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		switch n := x.(type) {
+		case int64:
+			i = n
+		case uint64:
+			if n > math.MaxInt64 {
+				return mismatch()
+			}
+			i = int64(n)
+		default:
+			return mismatch()
+		}
+		if v.OverflowInt(i) {
+			return fmt.Errorf("msgpack: %v overflow %v", i, v.Type())
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		switch n := x.(type) {
+		case uint64:
+			u = n
+		case int64:
+			if n < 0 {
+				return mismatch()
+			}
+			u = uint64(n)
+		default:
+			return mismatch()
+		}
+		if v.OverflowUint(u) {
+			return fmt.Errorf("msgpack: %v overflow %v", u, v.Type())
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		switch n := x.(type) {
+		case float64:
+			v.SetFloat(n)
+		case int64:
+			v.SetFloat(float64(n))
+		case uint64:
+			v.SetFloat(float64(n))
+		default:
+			return mismatch()
+		}
+	case reflect.String:
+		switch s := x.(type) {
+		case string:
+			v.SetString(s)
+		case []byte:
+			v.SetString(string(s))
+		default:
+			return mismatch()
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			var b []byte
+			switch s := x.(type) {
+			case []byte:
+				b = s
+			case string:
+				b = []byte(s)
+			}
+			if b != nil {
+				if v.Kind() == reflect.Slice {
+					v.SetBytes(append([]byte{}, b...))
+				} else {
+					reflect.Copy(v, reflect.ValueOf(b))
+				}
+				return nil
+			}
+		}
+		items, ok := x.([]any)
+		if !ok {
+			return mismatch()
+		}
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(v.Type(), len(items), len(items)))
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			if err := mpAssign(v.Index(i), items[i]); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		pairs, ok := x.([]mpPair)
+		if !ok {
+			return mismatch()
+		}
+		m := reflect.MakeMapWithSize(v.Type(), len(pairs))
+		for _, p := range pairs {
+			key := reflect.New(v.Type().Key()).Elem()
+			if err := mpAssign(key, p.key); err != nil {
+				return err
+			}
+			val := reflect.New(v.Type().Elem()).Elem()
+			if err := mpAssign(val, p.val); err != nil {
+				return err
+			}
+			m.SetMapIndex(key, val)
+		}
+		v.Set(m)
+	case reflect.Struct:
+		pairs, ok := x.([]mpPair)
+		if !ok {
+			return mismatch()
+		}
+		fields := structFields(v.Type(), "msgpack")
+		for _, p := range pairs {
+			name, ok := p.key.(string)
+			if !ok {
+				continue
+			}
+			if f := findField(fields, name); f != nil {
+				if err := mpAssign(v.Field(f.index), p.val); err != nil {
+					return fmt.Errorf("msgpack: field %v: %w", name, err)
+				}
+			}
+		}
+	default:
+		return mismatch()
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package codec
+
+import "encoding/xml"
+
+type XmlCodec struct {
+}
+
+func NewXmlCodec() *XmlCodec {
+	return &XmlCodec{}
+}
+
+func (this *XmlCodec) ContentType() string {
+	return MimeXML
+}
+
+func (this *XmlCodec) Marshal(v any) ([]byte, error) {
+	return xml.Marshal(v)
+}
+
+func (this *XmlCodec) Unmarshal(data []byte, v any) error {
+	return xml.Unmarshal(data, v)
+}
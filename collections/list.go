@@ -0,0 +1,223 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/mobilemindtech/go-io/option"
+)
+
+type listNode[T any] struct {
+	head T
+	tail *listNode[T]
+}
+
+// List is an immutable persistent linked list. Prepend, Head and Tail are O(1)
+// and share structure with the original list
+type List[T any] struct {
+	node *listNode[T]
+	size int
+}
+
+func EmptyList[T any]() *List[T] {
+	return &List[T]{}
+}
+
+func ListOf[T any](items ...T) *List[T] {
+	return ListFromSlice(items)
+}
+
+func ListFromSlice[T any](items []T) *List[T] {
+	list := EmptyList[T]()
+	for i := len(items) - 1; i >= 0; i-- {
+		list = list.Prepend(items[i])
+	}
+	return list
+}
+
+func ListFromSeq[T any](seq iter.Seq[T]) *List[T] {
+	var items []T
+	for it := range seq {
+		items = append(items, it)
+	}
+	return ListFromSlice(items)
+}
+
+func (this *List[T]) Len() int {
+	return this.size
+}
+
+func (this *List[T]) IsEmpty() bool {
+	return this.size == 0
+}
+
+func (this *List[T]) NonEmpty() bool {
+	return this.size > 0
+}
+
+// Prepend return a new list with value on head
+func (this *List[T]) Prepend(value T) *List[T] {
+	return &List[T]{node: &listNode[T]{head: value, tail: this.node}, size: this.size + 1}
+}
+
+// Append return a new list with value on end. It copy the list
+func (this *List[T]) Append(value T) *List[T] {
+	return ListFromSlice(append(this.ToSlice(), value))
+}
+
+func (this *List[T]) Concat(other *List[T]) *List[T] {
+	items := this.ToSlice()
+	result := other
+	for i := len(items) - 1; i >= 0; i-- {
+		result = result.Prepend(items[i])
+	}
+	return result
+}
+
+func (this *List[T]) Head() *option.Option[T] {
+	if this.IsEmpty() {
+		return option.None[T]()
+	}
+	return option.Some(this.node.head)
+}
+
+// Tail return list without head. Tail of empty list is empty
+func (this *List[T]) Tail() *List[T] {
+	if this.IsEmpty() {
+		return this
+	}
+	return &List[T]{node: this.node.tail, size: this.size - 1}
+}
+
+func (this *List[T]) Get(index int) *option.Option[T] {
+	i := 0
+	for it := range this.All() {
+		if i == index {
+			return option.Some(it)
+		}
+		i++
+	}
+	return option.None[T]()
+}
+
+func (this *List[T]) Reverse() *List[T] {
+	list := EmptyList[T]()
+	for it := range this.All() {
+		list = list.Prepend(it)
+	}
+	return list
+}
+
+func (this *List[T]) Filter(f func(T) bool) *List[T] {
+	var items []T
+	for it := range this.All() {
+		if f(it) {
+			items = append(items, it)
+		}
+	}
+	return ListFromSlice(items)
+}
+
+// Partition split list in values that satisfy f and values that not
+func (this *List[T]) Partition(f func(T) bool) (*List[T], *List[T]) {
+	var yes, no []T
+	for it := range this.All() {
+		if f(it) {
+			yes = append(yes, it)
+		} else {
+			no = append(no, it)
+		}
+	}
+	return ListFromSlice(yes), ListFromSlice(no)
+}
+
+func (this *List[T]) Find(f func(T) bool) *option.Option[T] {
+	for it := range this.All() {
+		if f(it) {
+			return option.Some(it)
+		}
+	}
+	return option.None[T]()
+}
+
+func (this *List[T]) Exists(f func(T) bool) bool {
+	return this.Find(f).NonEmpty()
+}
+
+func (this *List[T]) Foreach(f func(T)) *List[T] {
+	for it := range this.All() {
+		f(it)
+	}
+	return this
+}
+
+func (this *List[T]) ToSlice() []T {
+	items := make([]T, 0, this.size)
+	for it := range this.All() {
+		items = append(items, it)
+	}
+	return items
+}
+
+// All iterate list values
+func (this *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := this.node; n != nil; n = n.tail {
+			if !yield(n.head) {
+				return
+			}
+		}
+	}
+}
+
+func (this *List[T]) String() string {
+	items := make([]string, 0, this.size)
+	for it := range this.All() {
+		items = append(items, fmt.Sprintf("%v", it))
+	}
+	return fmt.Sprintf("List(%v)", strings.Join(items, ", "))
+}
+
+func ListMap[A, B any](list *List[A], f func(A) B) *List[B] {
+	items := make([]B, 0, list.Len())
+	for it := range list.All() {
+		items = append(items, f(it))
+	}
+	return ListFromSlice(items)
+}
+
+func ListFlatMap[A, B any](list *List[A], f func(A) *List[B]) *List[B] {
+	var items []B
+	for it := range list.All() {
+		items = append(items, f(it).ToSlice()...)
+	}
+	return ListFromSlice(items)
+}
+
+// ListFold fold list from left
+func ListFold[A, B any](list *List[A], zero B, f func(B, A) B) B {
+	acc := zero
+	for it := range list.All() {
+		acc = f(acc, it)
+	}
+	return acc
+}
+
+// ListGroupBy group values by key, keeping list order on groups
+func ListGroupBy[T any, K comparable](list *List[T], f func(T) K) *Map[K, *List[T]] {
+	groups := map[K][]T{}
+	var keys []K
+	for it := range list.All() {
+		k := f(it)
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], it)
+	}
+	result := EmptyMap[K, *List[T]]()
+	for _, k := range keys {
+		result = result.Put(k, ListFromSlice(groups[k]))
+	}
+	return result
+}
@@ -0,0 +1,351 @@
+package collections
+
+import (
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"math/bits"
+	"strings"
+
+	"github.com/mobilemindtech/go-io/option"
+)
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+var hamtSeed = maphash.MakeSeed()
+
+type pair[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// hamtEntry is a child node or a leaf. Leaf has more than one pair only on hash collision
+type hamtEntry[K comparable, V any] struct {
+	child *hamtNode[K, V]
+	hash  uint64
+	pairs []pair[K, V]
+}
+
+type hamtNode[K comparable, V any] struct {
+	bitmap  uint32
+	entries []*hamtEntry[K, V]
+}
+
+// Map is an immutable persistent hash array mapped trie. Updates copy only the path
+// to the changed key. Iteration order is not specified
+type Map[K comparable, V any] struct {
+	root *hamtNode[K, V]
+	size int
+}
+
+func EmptyMap[K comparable, V any]() *Map[K, V] {
+	return &Map[K, V]{root: &hamtNode[K, V]{}}
+}
+
+func MapFromGo[K comparable, V any](m map[K]V) *Map[K, V] {
+	result := EmptyMap[K, V]()
+	for k, v := range m {
+		result = result.Put(k, v)
+	}
+	return result
+}
+
+func MapFromSeq[K comparable, V any](seq iter.Seq2[K, V]) *Map[K, V] {
+	result := EmptyMap[K, V]()
+	for k, v := range seq {
+		result = result.Put(k, v)
+	}
+	return result
+}
+
+func (this *Map[K, V]) Len() int {
+	return this.size
+}
+
+func (this *Map[K, V]) IsEmpty() bool {
+	return this.size == 0
+}
+
+func (this *Map[K, V]) NonEmpty() bool {
+	return this.size > 0
+}
+
+func (this *Map[K, V]) Get(key K) *option.Option[V] {
+	hash := hashOf(key)
+	node := this.root
+	for shift := 0; ; shift += hamtBits {
+		bit, pos := node.position(hash, shift)
+		if node.bitmap&bit == 0 {
+			return option.None[V]()
+		}
+		entry := node.entries[pos]
+		if entry.child != nil {
+			node = entry.child
+			continue
+		}
+		if entry.hash == hash {
+			for _, p := range entry.pairs {
+				if p.key == key {
+					return option.Some(p.value)
+				}
+			}
+		}
+		return option.None[V]()
+	}
+}
+
+func (this *Map[K, V]) Contains(key K) bool {
+	return this.Get(key).NonEmpty()
+}
+
+// Put return a new map with key set to value
+func (this *Map[K, V]) Put(key K, value V) *Map[K, V] {
+	root, added := this.root.put(hashOf(key), 0, key, value)
+	size := this.size
+	if added {
+		size++
+	}
+	return &Map[K, V]{root: root, size: size}
+}
+
+// Remove return a new map without key
+func (this *Map[K, V]) Remove(key K) *Map[K, V] {
+	root, removed := this.root.remove(hashOf(key), 0, key)
+	if !removed {
+		return this
+	}
+	return &Map[K, V]{root: root, size: this.size - 1}
+}
+
+func (this *Map[K, V]) Keys() []K {
+	keys := make([]K, 0, this.size)
+	for k := range this.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (this *Map[K, V]) Values() []V {
+	values := make([]V, 0, this.size)
+	for _, v := range this.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (this *Map[K, V]) Filter(f func(K, V) bool) *Map[K, V] {
+	result := this
+	for k, v := range this.All() {
+		if !f(k, v) {
+			result = result.Remove(k)
+		}
+	}
+	return result
+}
+
+// Partition split map in entries that satisfy f and entries that not
+func (this *Map[K, V]) Partition(f func(K, V) bool) (*Map[K, V], *Map[K, V]) {
+	yes, no := EmptyMap[K, V](), EmptyMap[K, V]()
+	for k, v := range this.All() {
+		if f(k, v) {
+			yes = yes.Put(k, v)
+		} else {
+			no = no.Put(k, v)
+		}
+	}
+	return yes, no
+}
+
+func (this *Map[K, V]) Foreach(f func(K, V)) *Map[K, V] {
+	for k, v := range this.All() {
+		f(k, v)
+	}
+	return this
+}
+
+func (this *Map[K, V]) ToGo() map[K]V {
+	m := make(map[K]V, this.size)
+	for k, v := range this.All() {
+		m[k] = v
+	}
+	return m
+}
+
+// All iterate map keys and values
+func (this *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		this.root.each(yield)
+	}
+}
+
+func (this *Map[K, V]) String() string {
+	items := make([]string, 0, this.size)
+	for k, v := range this.All() {
+		items = append(items, fmt.Sprintf("%v: %v", k, v))
+	}
+	return fmt.Sprintf("Map(%v)", strings.Join(items, ", "))
+}
+
+// MapValues map values keeping keys
+func MapValues[K comparable, A, B any](m *Map[K, A], f func(A) B) *Map[K, B] {
+	result := EmptyMap[K, B]()
+	for k, v := range m.All() {
+		result = result.Put(k, f(v))
+	}
+	return result
+}
+
+// MapFold fold map entries
+func MapFold[K comparable, V, B any](m *Map[K, V], zero B, f func(B, K, V) B) B {
+	acc := zero
+	for k, v := range m.All() {
+		acc = f(acc, k, v)
+	}
+	return acc
+}
+
+// MapGroupBy group entries by key
+func MapGroupBy[K comparable, V any, G comparable](m *Map[K, V], f func(K, V) G) *Map[G, *Map[K, V]] {
+	result := EmptyMap[G, *Map[K, V]]()
+	for k, v := range m.All() {
+		g := f(k, v)
+		group := result.Get(g).Or(EmptyMap[K, V]())
+		result = result.Put(g, group.Put(k, v))
+	}
+	return result
+}
+
+func hashOf[K comparable](key K) uint64 {
+	return maphash.Comparable(hamtSeed, key)
+}
+
+func (this *hamtNode[K, V]) position(hash uint64, shift int) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	return bit, bits.OnesCount32(this.bitmap & (bit - 1))
+}
+
+func (this *hamtNode[K, V]) with(pos int, entry *hamtEntry[K, V]) *hamtNode[K, V] {
+	entries := make([]*hamtEntry[K, V], len(this.entries))
+	copy(entries, this.entries)
+	entries[pos] = entry
+	return &hamtNode[K, V]{bitmap: this.bitmap, entries: entries}
+}
+
+func (this *hamtNode[K, V]) put(hash uint64, shift int, key K, value V) (*hamtNode[K, V], bool) {
+	bit, pos := this.position(hash, shift)
+	leaf := &hamtEntry[K, V]{hash: hash, pairs: []pair[K, V]{{key, value}}}
+
+	if this.bitmap&bit == 0 {
+		entries := make([]*hamtEntry[K, V], 0, len(this.entries)+1)
+		entries = append(entries, this.entries[:pos]...)
+		entries = append(entries, leaf)
+		entries = append(entries, this.entries[pos:]...)
+		return &hamtNode[K, V]{bitmap: this.bitmap | bit, entries: entries}, true
+	}
+
+	entry := this.entries[pos]
+
+	if entry.child != nil {
+		child, added := entry.child.put(hash, shift+hamtBits, key, value)
+		return this.with(pos, &hamtEntry[K, V]{child: child}), added
+	}
+
+	if entry.hash == hash {
+		pairs := make([]pair[K, V], len(entry.pairs))
+		copy(pairs, entry.pairs)
+		for i, p := range pairs {
+			if p.key == key {
+				pairs[i].value = value
+				return this.with(pos, &hamtEntry[K, V]{hash: hash, pairs: pairs}), false
+			}
+		}
+		pairs = append(pairs, pair[K, V]{key, value})
+		return this.with(pos, &hamtEntry[K, V]{hash: hash, pairs: pairs}), true
+	}
+
+	child := mergeLeaves(entry, leaf, shift+hamtBits)
+	return this.with(pos, &hamtEntry[K, V]{child: child}), true
+}
+
+// mergeLeaves create a node with two leaves of different hashes
+func mergeLeaves[K comparable, V any](a *hamtEntry[K, V], b *hamtEntry[K, V], shift int) *hamtNode[K, V] {
+	idxA := uint32((a.hash >> shift) & hamtMask)
+	idxB := uint32((b.hash >> shift) & hamtMask)
+	if idxA == idxB {
+		child := mergeLeaves(a, b, shift+hamtBits)
+		return &hamtNode[K, V]{bitmap: 1 << idxA, entries: []*hamtEntry[K, V]{{child: child}}}
+	}
+	if idxA > idxB {
+		a, b = b, a
+	}
+	return &hamtNode[K, V]{bitmap: 1<<idxA | 1<<idxB, entries: []*hamtEntry[K, V]{a, b}}
+}
+
+func (this *hamtNode[K, V]) remove(hash uint64, shift int, key K) (*hamtNode[K, V], bool) {
+	bit, pos := this.position(hash, shift)
+	if this.bitmap&bit == 0 {
+		return this, false
+	}
+
+	entry := this.entries[pos]
+
+	if entry.child != nil {
+		child, removed := entry.child.remove(hash, shift+hamtBits, key)
+		if !removed {
+			return this, false
+		}
+		switch {
+		case len(child.entries) == 0:
+			return this.without(pos, bit), true
+		case len(child.entries) == 1 && child.entries[0].child == nil:
+			// pull up single leaf
+			return this.with(pos, child.entries[0]), true
+		default:
+			return this.with(pos, &hamtEntry[K, V]{child: child}), true
+		}
+	}
+
+	if entry.hash != hash {
+		return this, false
+	}
+
+	for i, p := range entry.pairs {
+		if p.key == key {
+			if len(entry.pairs) == 1 {
+				return this.without(pos, bit), true
+			}
+			pairs := make([]pair[K, V], 0, len(entry.pairs)-1)
+			pairs = append(pairs, entry.pairs[:i]...)
+			pairs = append(pairs, entry.pairs[i+1:]...)
+			return this.with(pos, &hamtEntry[K, V]{hash: hash, pairs: pairs}), true
+		}
+	}
+	return this, false
+}
+
+func (this *hamtNode[K, V]) without(pos int, bit uint32) *hamtNode[K, V] {
+	entries := make([]*hamtEntry[K, V], 0, len(this.entries)-1)
+	entries = append(entries, this.entries[:pos]...)
+	entries = append(entries, this.entries[pos+1:]...)
+	return &hamtNode[K, V]{bitmap: this.bitmap &^ bit, entries: entries}
+}
+
+func (this *hamtNode[K, V]) each(yield func(K, V) bool) bool {
+	for _, entry := range this.entries {
+		if entry.child != nil {
+			if !entry.child.each(yield) {
+				return false
+			}
+			continue
+		}
+		for _, p := range entry.pairs {
+			if !yield(p.key, p.value) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,146 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// Set is an immutable persistent set backed by Map
+type Set[T comparable] struct {
+	items *Map[T, struct{}]
+}
+
+func EmptySet[T comparable]() *Set[T] {
+	return &Set[T]{items: EmptyMap[T, struct{}]()}
+}
+
+func SetOf[T comparable](items ...T) *Set[T] {
+	return SetFromSlice(items)
+}
+
+func SetFromSlice[T comparable](items []T) *Set[T] {
+	set := EmptySet[T]()
+	for _, it := range items {
+		set = set.Add(it)
+	}
+	return set
+}
+
+func SetFromSeq[T comparable](seq iter.Seq[T]) *Set[T] {
+	set := EmptySet[T]()
+	for it := range seq {
+		set = set.Add(it)
+	}
+	return set
+}
+
+func (this *Set[T]) Len() int {
+	return this.items.Len()
+}
+
+func (this *Set[T]) IsEmpty() bool {
+	return this.items.IsEmpty()
+}
+
+func (this *Set[T]) NonEmpty() bool {
+	return this.items.NonEmpty()
+}
+
+func (this *Set[T]) Contains(value T) bool {
+	return this.items.Contains(value)
+}
+
+// Add return a new set with value
+func (this *Set[T]) Add(value T) *Set[T] {
+	if this.Contains(value) {
+		return this
+	}
+	return &Set[T]{items: this.items.Put(value, struct{}{})}
+}
+
+// Remove return a new set without value
+func (this *Set[T]) Remove(value T) *Set[T] {
+	return &Set[T]{items: this.items.Remove(value)}
+}
+
+func (this *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := this
+	for it := range other.All() {
+		result = result.Add(it)
+	}
+	return result
+}
+
+func (this *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	return this.Filter(other.Contains)
+}
+
+func (this *Set[T]) Diff(other *Set[T]) *Set[T] {
+	return this.Filter(func(it T) bool { return !other.Contains(it) })
+}
+
+func (this *Set[T]) Filter(f func(T) bool) *Set[T] {
+	return &Set[T]{items: this.items.Filter(func(it T, _ struct{}) bool { return f(it) })}
+}
+
+// Partition split set in values that satisfy f and values that not
+func (this *Set[T]) Partition(f func(T) bool) (*Set[T], *Set[T]) {
+	yes, no := this.items.Partition(func(it T, _ struct{}) bool { return f(it) })
+	return &Set[T]{items: yes}, &Set[T]{items: no}
+}
+
+func (this *Set[T]) Foreach(f func(T)) *Set[T] {
+	for it := range this.All() {
+		f(it)
+	}
+	return this
+}
+
+func (this *Set[T]) ToSlice() []T {
+	return this.items.Keys()
+}
+
+// All iterate set values
+func (this *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k := range this.items.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (this *Set[T]) String() string {
+	items := make([]string, 0, this.Len())
+	for it := range this.All() {
+		items = append(items, fmt.Sprintf("%v", it))
+	}
+	return fmt.Sprintf("Set(%v)", strings.Join(items, ", "))
+}
+
+func SetMap[A, B comparable](set *Set[A], f func(A) B) *Set[B] {
+	result := EmptySet[B]()
+	for it := range set.All() {
+		result = result.Add(f(it))
+	}
+	return result
+}
+
+func SetFold[A comparable, B any](set *Set[A], zero B, f func(B, A) B) B {
+	acc := zero
+	for it := range set.All() {
+		acc = f(acc, it)
+	}
+	return acc
+}
+
+func SetGroupBy[T comparable, K comparable](set *Set[T], f func(T) K) *Map[K, *Set[T]] {
+	result := EmptyMap[K, *Set[T]]()
+	for it := range set.All() {
+		k := f(it)
+		result = result.Put(k, result.Get(k).Or(EmptySet[T]()).Add(it))
+	}
+	return result
+}
@@ -1,6 +1,8 @@
 package collections
 
 import (
+	"iter"
+
 	"github.com/mobilemindtech/go-io/option"
 )
 
@@ -64,6 +66,22 @@ func (this *Stack[T]) Last() T {
 	return this.items[0]
 }
 
+// All iterate stack from top to bottom, like Pop order
+func (this *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(this.items) - 1; i >= 0; i-- {
+			if !yield(this.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values iterate stack from top to bottom, same order of All
+func (this *Stack[T]) Values() iter.Seq[T] {
+	return this.All()
+}
+
 func StackCopy[T any](stack *Stack[T]) *Stack[T] {
 	st := NewStack[T]()
 	for _, it := range stack.items {
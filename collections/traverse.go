@@ -0,0 +1,63 @@
+package collections
+
+import (
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+)
+
+// TraverseOption map values with f. Return None if any value is None
+func TraverseOption[A, B any](list *List[A], f func(A) *option.Option[B]) *option.Option[*List[B]] {
+	items := make([]B, 0, list.Len())
+	for it := range list.All() {
+		opt := f(it)
+		if opt.IsEmpty() {
+			return option.None[*List[B]]()
+		}
+		items = append(items, opt.Get())
+	}
+	return option.Some(ListFromSlice(items))
+}
+
+func SequenceOption[A any](list *List[*option.Option[A]]) *option.Option[*List[A]] {
+	return TraverseOption(list, func(opt *option.Option[A]) *option.Option[A] { return opt })
+}
+
+// TraverseResult map values with f. Return the first error
+func TraverseResult[A, B any](list *List[A], f func(A) *result.Result[B]) *result.Result[*List[B]] {
+	items := make([]B, 0, list.Len())
+	for it := range list.All() {
+		res := f(it)
+		if res.IsError() {
+			return result.OfError[*List[B]](res.Failure())
+		}
+		items = append(items, res.Get())
+	}
+	return result.OfValue(ListFromSlice(items))
+}
+
+func SequenceResult[A any](list *List[*result.Result[A]]) *result.Result[*List[A]] {
+	return TraverseResult(list, func(res *result.Result[A]) *result.Result[A] { return res })
+}
+
+// TraverseIO run f IO of each value in order. Stop on first error or empty IO
+func TraverseIO[A, B any](list *List[A], f func(A) *rio.IO[B]) *rio.IO[*List[B]] {
+	return rio.AttemptThenOfOption(rio.Pure(list), func(list *List[A]) *result.Result[*option.Option[*List[B]]] {
+		items := make([]B, 0, list.Len())
+		for it := range list.All() {
+			res := rio.UnsafeRun(f(it))
+			if res.IsError() {
+				return result.OfErrorOption[*List[B]](res.Failure())
+			}
+			if res.Get().IsEmpty() {
+				return result.OfNone[*List[B]]()
+			}
+			items = append(items, res.Get().Get())
+		}
+		return result.OfSome(ListFromSlice(items))
+	}).As("TraverseIO")
+}
+
+func SequenceIO[A any](list *List[*rio.IO[A]]) *rio.IO[*List[A]] {
+	return TraverseIO(list, func(io *rio.IO[A]) *rio.IO[A] { return io })
+}
@@ -0,0 +1,295 @@
+// Code generated by go-io/internal/gen. DO NOT EDIT.
+
+package effect
+
+import "github.com/mobilemindtech/go-io/result"
+
+// Effect with 1 arg
+type EffectT1[T any, T1 any] struct {
+	f      func(T1) *result.Result[T]
+	result *result.Result[T]
+}
+
+func NewEffectT1[T any, T1 any](f func(T1) *result.Result[T]) *EffectT1[T, T1] {
+	return &EffectT1[T, T1]{f: f}
+}
+
+func (this *EffectT1[T, T1]) ArgsCount() int {
+	return 1
+}
+
+func (this *EffectT1[T, T1]) Run(v1 T1) *EffectT1[T, T1] {
+	r := this.f(v1)
+	if r == nil {
+		panic("effect can't return nil")
+	}
+	return &EffectT1[T, T1]{result: r, f: this.f}
+}
+
+func (this *EffectT1[T, T1]) Result() *result.Result[T] {
+	if this.result == nil {
+		panic("effect was not executed")
+	}
+	return this.result
+}
+
+// Effect with 2 args
+type EffectT2[T any, T1 any, T2 any] struct {
+	f      func(T1, T2) *result.Result[T]
+	result *result.Result[T]
+}
+
+func NewEffectT2[T any, T1 any, T2 any](f func(T1, T2) *result.Result[T]) *EffectT2[T, T1, T2] {
+	return &EffectT2[T, T1, T2]{f: f}
+}
+
+func (this *EffectT2[T, T1, T2]) ArgsCount() int {
+	return 2
+}
+
+func (this *EffectT2[T, T1, T2]) Run(v1 T1, v2 T2) *EffectT2[T, T1, T2] {
+	r := this.f(v1, v2)
+	if r == nil {
+		panic("effect can't return nil")
+	}
+	return &EffectT2[T, T1, T2]{result: r, f: this.f}
+}
+
+func (this *EffectT2[T, T1, T2]) Result() *result.Result[T] {
+	if this.result == nil {
+		panic("effect was not executed")
+	}
+	return this.result
+}
+
+// Effect with 3 args
+type EffectT3[T any, T1 any, T2 any, T3 any] struct {
+	f      func(T1, T2, T3) *result.Result[T]
+	result *result.Result[T]
+}
+
+func NewEffectT3[T any, T1 any, T2 any, T3 any](f func(T1, T2, T3) *result.Result[T]) *EffectT3[T, T1, T2, T3] {
+	return &EffectT3[T, T1, T2, T3]{f: f}
+}
+
+func (this *EffectT3[T, T1, T2, T3]) ArgsCount() int {
+	return 3
+}
+
+func (this *EffectT3[T, T1, T2, T3]) Run(v1 T1, v2 T2, v3 T3) *EffectT3[T, T1, T2, T3] {
+	r := this.f(v1, v2, v3)
+	if r == nil {
+		panic("effect can't return nil")
+	}
+	return &EffectT3[T, T1, T2, T3]{result: r, f: this.f}
+}
+
+func (this *EffectT3[T, T1, T2, T3]) Result() *result.Result[T] {
+	if this.result == nil {
+		panic("effect was not executed")
+	}
+	return this.result
+}
+
+// Effect with 4 args
+type EffectT4[T any, T1 any, T2 any, T3 any, T4 any] struct {
+	f      func(T1, T2, T3, T4) *result.Result[T]
+	result *result.Result[T]
+}
+
+func NewEffectT4[T any, T1 any, T2 any, T3 any, T4 any](f func(T1, T2, T3, T4) *result.Result[T]) *EffectT4[T, T1, T2, T3, T4] {
+	return &EffectT4[T, T1, T2, T3, T4]{f: f}
+}
+
+func (this *EffectT4[T, T1, T2, T3, T4]) ArgsCount() int {
+	return 4
+}
+
+func (this *EffectT4[T, T1, T2, T3, T4]) Run(v1 T1, v2 T2, v3 T3, v4 T4) *EffectT4[T, T1, T2, T3, T4] {
+	r := this.f(v1, v2, v3, v4)
+	if r == nil {
+		panic("effect can't return nil")
+	}
+	return &EffectT4[T, T1, T2, T3, T4]{result: r, f: this.f}
+}
+
+func (this *EffectT4[T, T1, T2, T3, T4]) Result() *result.Result[T] {
+	if this.result == nil {
+		panic("effect was not executed")
+	}
+	return this.result
+}
+
+// Effect with 5 args
+type EffectT5[T any, T1 any, T2 any, T3 any, T4 any, T5 any] struct {
+	f      func(T1, T2, T3, T4, T5) *result.Result[T]
+	result *result.Result[T]
+}
+
+func NewEffectT5[T any, T1 any, T2 any, T3 any, T4 any, T5 any](f func(T1, T2, T3, T4, T5) *result.Result[T]) *EffectT5[T, T1, T2, T3, T4, T5] {
+	return &EffectT5[T, T1, T2, T3, T4, T5]{f: f}
+}
+
+func (this *EffectT5[T, T1, T2, T3, T4, T5]) ArgsCount() int {
+	return 5
+}
+
+func (this *EffectT5[T, T1, T2, T3, T4, T5]) Run(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5) *EffectT5[T, T1, T2, T3, T4, T5] {
+	r := this.f(v1, v2, v3, v4, v5)
+	if r == nil {
+		panic("effect can't return nil")
+	}
+	return &EffectT5[T, T1, T2, T3, T4, T5]{result: r, f: this.f}
+}
+
+func (this *EffectT5[T, T1, T2, T3, T4, T5]) Result() *result.Result[T] {
+	if this.result == nil {
+		panic("effect was not executed")
+	}
+	return this.result
+}
+
+// Effect with 6 args
+type EffectT6[T any, T1 any, T2 any, T3 any, T4 any, T5 any, T6 any] struct {
+	f      func(T1, T2, T3, T4, T5, T6) *result.Result[T]
+	result *result.Result[T]
+}
+
+func NewEffectT6[T any, T1 any, T2 any, T3 any, T4 any, T5 any, T6 any](f func(T1, T2, T3, T4, T5, T6) *result.Result[T]) *EffectT6[T, T1, T2, T3, T4, T5, T6] {
+	return &EffectT6[T, T1, T2, T3, T4, T5, T6]{f: f}
+}
+
+func (this *EffectT6[T, T1, T2, T3, T4, T5, T6]) ArgsCount() int {
+	return 6
+}
+
+func (this *EffectT6[T, T1, T2, T3, T4, T5, T6]) Run(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6) *EffectT6[T, T1, T2, T3, T4, T5, T6] {
+	r := this.f(v1, v2, v3, v4, v5, v6)
+	if r == nil {
+		panic("effect can't return nil")
+	}
+	return &EffectT6[T, T1, T2, T3, T4, T5, T6]{result: r, f: this.f}
+}
+
+func (this *EffectT6[T, T1, T2, T3, T4, T5, T6]) Result() *result.Result[T] {
+	if this.result == nil {
+		panic("effect was not executed")
+	}
+	return this.result
+}
+
+// Effect with 7 args
+type EffectT7[T any, T1 any, T2 any, T3 any, T4 any, T5 any, T6 any, T7 any] struct {
+	f      func(T1, T2, T3, T4, T5, T6, T7) *result.Result[T]
+	result *result.Result[T]
+}
+
+func NewEffectT7[T any, T1 any, T2 any, T3 any, T4 any, T5 any, T6 any, T7 any](f func(T1, T2, T3, T4, T5, T6, T7) *result.Result[T]) *EffectT7[T, T1, T2, T3, T4, T5, T6, T7] {
+	return &EffectT7[T, T1, T2, T3, T4, T5, T6, T7]{f: f}
+}
+
+func (this *EffectT7[T, T1, T2, T3, T4, T5, T6, T7]) ArgsCount() int {
+	return 7
+}
+
+func (this *EffectT7[T, T1, T2, T3, T4, T5, T6, T7]) Run(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6, v7 T7) *EffectT7[T, T1, T2, T3, T4, T5, T6, T7] {
+	r := this.f(v1, v2, v3, v4, v5, v6, v7)
+	if r == nil {
+		panic("effect can't return nil")
+	}
+	return &EffectT7[T, T1, T2, T3, T4, T5, T6, T7]{result: r, f: this.f}
+}
+
+func (this *EffectT7[T, T1, T2, T3, T4, T5, T6, T7]) Result() *result.Result[T] {
+	if this.result == nil {
+		panic("effect was not executed")
+	}
+	return this.result
+}
+
+// Effect with 8 args
+type EffectT8[T any, T1 any, T2 any, T3 any, T4 any, T5 any, T6 any, T7 any, T8 any] struct {
+	f      func(T1, T2, T3, T4, T5, T6, T7, T8) *result.Result[T]
+	result *result.Result[T]
+}
+
+func NewEffectT8[T any, T1 any, T2 any, T3 any, T4 any, T5 any, T6 any, T7 any, T8 any](f func(T1, T2, T3, T4, T5, T6, T7, T8) *result.Result[T]) *EffectT8[T, T1, T2, T3, T4, T5, T6, T7, T8] {
+	return &EffectT8[T, T1, T2, T3, T4, T5, T6, T7, T8]{f: f}
+}
+
+func (this *EffectT8[T, T1, T2, T3, T4, T5, T6, T7, T8]) ArgsCount() int {
+	return 8
+}
+
+func (this *EffectT8[T, T1, T2, T3, T4, T5, T6, T7, T8]) Run(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6, v7 T7, v8 T8) *EffectT8[T, T1, T2, T3, T4, T5, T6, T7, T8] {
+	r := this.f(v1, v2, v3, v4, v5, v6, v7, v8)
+	if r == nil {
+		panic("effect can't return nil")
+	}
+	return &EffectT8[T, T1, T2, T3, T4, T5, T6, T7, T8]{result: r, f: this.f}
+}
+
+func (this *EffectT8[T, T1, T2, T3, T4, T5, T6, T7, T8]) Result() *result.Result[T] {
+	if this.result == nil {
+		panic("effect was not executed")
+	}
+	return this.result
+}
+
+// Effect with 9 args
+type EffectT9[T any, T1 any, T2 any, T3 any, T4 any, T5 any, T6 any, T7 any, T8 any, T9 any] struct {
+	f      func(T1, T2, T3, T4, T5, T6, T7, T8, T9) *result.Result[T]
+	result *result.Result[T]
+}
+
+func NewEffectT9[T any, T1 any, T2 any, T3 any, T4 any, T5 any, T6 any, T7 any, T8 any, T9 any](f func(T1, T2, T3, T4, T5, T6, T7, T8, T9) *result.Result[T]) *EffectT9[T, T1, T2, T3, T4, T5, T6, T7, T8, T9] {
+	return &EffectT9[T, T1, T2, T3, T4, T5, T6, T7, T8, T9]{f: f}
+}
+
+func (this *EffectT9[T, T1, T2, T3, T4, T5, T6, T7, T8, T9]) ArgsCount() int {
+	return 9
+}
+
+func (this *EffectT9[T, T1, T2, T3, T4, T5, T6, T7, T8, T9]) Run(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6, v7 T7, v8 T8, v9 T9) *EffectT9[T, T1, T2, T3, T4, T5, T6, T7, T8, T9] {
+	r := this.f(v1, v2, v3, v4, v5, v6, v7, v8, v9)
+	if r == nil {
+		panic("effect can't return nil")
+	}
+	return &EffectT9[T, T1, T2, T3, T4, T5, T6, T7, T8, T9]{result: r, f: this.f}
+}
+
+func (this *EffectT9[T, T1, T2, T3, T4, T5, T6, T7, T8, T9]) Result() *result.Result[T] {
+	if this.result == nil {
+		panic("effect was not executed")
+	}
+	return this.result
+}
+
+// Effect with 10 args
+type EffectT10[T any, T1 any, T2 any, T3 any, T4 any, T5 any, T6 any, T7 any, T8 any, T9 any, T10 any] struct {
+	f      func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10) *result.Result[T]
+	result *result.Result[T]
+}
+
+func NewEffectT10[T any, T1 any, T2 any, T3 any, T4 any, T5 any, T6 any, T7 any, T8 any, T9 any, T10 any](f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10) *result.Result[T]) *EffectT10[T, T1, T2, T3, T4, T5, T6, T7, T8, T9, T10] {
+	return &EffectT10[T, T1, T2, T3, T4, T5, T6, T7, T8, T9, T10]{f: f}
+}
+
+func (this *EffectT10[T, T1, T2, T3, T4, T5, T6, T7, T8, T9, T10]) ArgsCount() int {
+	return 10
+}
+
+func (this *EffectT10[T, T1, T2, T3, T4, T5, T6, T7, T8, T9, T10]) Run(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6, v7 T7, v8 T8, v9 T9, v10 T10) *EffectT10[T, T1, T2, T3, T4, T5, T6, T7, T8, T9, T10] {
+	r := this.f(v1, v2, v3, v4, v5, v6, v7, v8, v9, v10)
+	if r == nil {
+		panic("effect can't return nil")
+	}
+	return &EffectT10[T, T1, T2, T3, T4, T5, T6, T7, T8, T9, T10]{result: r, f: this.f}
+}
+
+func (this *EffectT10[T, T1, T2, T3, T4, T5, T6, T7, T8, T9, T10]) Result() *result.Result[T] {
+	if this.result == nil {
+		panic("effect was not executed")
+	}
+	return this.result
+}
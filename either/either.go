@@ -15,11 +15,11 @@ type IEither interface {
 	Error() string
 }
 
-type _Left[T error] struct {
+type _Left[T any] struct {
 	value T
 }
 
-func _newLeft[T error](value T) *_Left[T] {
+func _newLeft[T any](value T) *_Left[T] {
 	return &_Left[T]{value: value}
 }
 
@@ -44,19 +44,20 @@ func (this _Right[T]) Get() T {
 }
 
 func (this _Right[T]) String() string {
-	return fmt.Sprintf("Left(%v)", this.value)
+	return fmt.Sprintf("Right(%v)", this.value)
 }
 
-type Either[A error, B any] struct {
+// Either is a Left or a Right value. By convention Left is the failure side
+type Either[A any, B any] struct {
 	left  *_Left[A]
 	right *_Right[B]
 }
 
-func Left[A error, B any](value A) *Either[A, B] {
+func Left[A any, B any](value A) *Either[A, B] {
 	return &Either[A, B]{left: _newLeft(value)}
 }
 
-func Right[A error, B any](value B) *Either[A, B] {
+func Right[A any, B any](value B) *Either[A, B] {
 	return &Either[A, B]{right: _newRight(value)}
 }
 
@@ -90,7 +91,7 @@ func (this Either[A, B]) RightAny() interface{} {
 }
 
 func (this Either[A, B]) Error() string {
-	return this.Left().Error()
+	return leftError(this.Left())
 }
 
 func (this Either[A, B]) String() string {
@@ -128,6 +129,22 @@ func (this Either[A, B]) ToRightOption() *option.Option[B] {
 	return option.None[B]()
 }
 
+// Swap left and right
+func (this Either[A, B]) Swap() *Either[B, A] {
+	if this.IsLeft() {
+		return Right[B, A](this.Left())
+	}
+	return Left[B, A](this.Right())
+}
+
+// RightOr get right value or other
+func (this Either[A, B]) RightOr(other B) B {
+	if this.IsRight() {
+		return this.Right()
+	}
+	return other
+}
+
 type EitherE[A any] struct {
 	*Either[error, A]
 }
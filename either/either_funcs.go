@@ -0,0 +1,111 @@
+package either
+
+import (
+	"fmt"
+
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+)
+
+// LeftError is an error with a left value that is not an error
+type LeftError[L any] struct {
+	Value L
+}
+
+func (this *LeftError[L]) Error() string {
+	return fmt.Sprintf("left: %v", this.Value)
+}
+
+// ToError get left value as error. Values that are not errors are wrapped in *LeftError
+func ToError[L any](left L) error {
+	if err, ok := any(left).(error); ok {
+		return err
+	}
+	return &LeftError[L]{Value: left}
+}
+
+func leftError[L any](left L) string {
+	return ToError(left).Error()
+}
+
+// Map right value
+func Map[L, A, B any](e *Either[L, A], f func(A) B) *Either[L, B] {
+	if e.IsLeft() {
+		return Left[L, B](e.Left())
+	}
+	return Right[L](f(e.Right()))
+}
+
+// MapLeft left value
+func MapLeft[A, B, R any](e *Either[A, R], f func(A) B) *Either[B, R] {
+	if e.IsLeft() {
+		return Left[B, R](f(e.Left()))
+	}
+	return Right[B](e.Right())
+}
+
+// Bimap left or right value
+func Bimap[A, B, C, D any](e *Either[A, B], fl func(A) C, fr func(B) D) *Either[C, D] {
+	if e.IsLeft() {
+		return Left[C, D](fl(e.Left()))
+	}
+	return Right[C](fr(e.Right()))
+}
+
+// FlatMap right value
+func FlatMap[L, A, B any](e *Either[L, A], f func(A) *Either[L, B]) *Either[L, B] {
+	if e.IsLeft() {
+		return Left[L, B](e.Left())
+	}
+	return f(e.Right())
+}
+
+// Fold left or right value to a single value
+func Fold[L, R, T any](e *Either[L, R], fl func(L) T, fr func(R) T) T {
+	if e.IsLeft() {
+		return fl(e.Left())
+	}
+	return fr(e.Right())
+}
+
+// Traverse map values with f. Return the first left
+func Traverse[L, A, B any](items []A, f func(A) *Either[L, B]) *Either[L, []B] {
+	values := make([]B, 0, len(items))
+	for _, it := range items {
+		e := f(it)
+		if e.IsLeft() {
+			return Left[L, []B](e.Left())
+		}
+		values = append(values, e.Right())
+	}
+	return Right[L](values)
+}
+
+// Sequence of eithers. Return the first left
+func Sequence[L, R any](items []*Either[L, R]) *Either[L, []R] {
+	return Traverse(items, func(e *Either[L, R]) *Either[L, R] { return e })
+}
+
+// FromResult create Right of value or Left of error
+func FromResult[R any](res *result.Result[R]) *Either[error, R] {
+	if res.IsError() {
+		return Left[error, R](res.Failure())
+	}
+	return Right[error](res.Get())
+}
+
+// ToResult convert either to result. Left values that are not errors are wrapped in *LeftError
+func ToResult[L, R any](e *Either[L, R]) *result.Result[R] {
+	if e.IsLeft() {
+		return result.OfError[R](ToError(e.Left()))
+	}
+	return result.OfValue(e.Right())
+}
+
+// FromOption create Right of value or Left of left func
+func FromOption[L, R any](opt *option.Option[R], left func() L) *Either[L, R] {
+	if opt.IsEmpty() {
+		return Left[L, R](left())
+	}
+	return Right[L](opt.Get())
+}
@@ -0,0 +1,76 @@
+package either
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// eitherJSON is the JSON shape of Either: {"left":v} or {"right":v}
+type eitherJSON struct {
+	Left  json.RawMessage `json:"left,omitempty"`
+	Right json.RawMessage `json:"right,omitempty"`
+}
+
+func (this Either[A, B]) MarshalJSON() ([]byte, error) {
+	if this.IsLeft() {
+		left, err := json.Marshal(leftValue(this.Left()))
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(&eitherJSON{Left: left})
+	}
+	right, err := json.Marshal(this.Right())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&eitherJSON{Right: right})
+}
+
+func (this *Either[A, B]) UnmarshalJSON(data []byte) error {
+	var shape eitherJSON
+	if err := json.Unmarshal(data, &shape); err != nil {
+		return err
+	}
+	switch {
+	case shape.Left != nil && shape.Right == nil:
+		left, err := decodeLeft[A](shape.Left)
+		if err != nil {
+			return err
+		}
+		*this = *Left[A, B](left)
+	case shape.Right != nil && shape.Left == nil:
+		var right B
+		if err := json.Unmarshal(shape.Right, &right); err != nil {
+			return err
+		}
+		*this = *Right[A](right)
+	default:
+		return fmt.Errorf("either should have exactly one of left or right: %v", string(data))
+	}
+	return nil
+}
+
+// leftValue encode errors that are not json.Marshaler as their message
+func leftValue(left any) any {
+	if _, ok := left.(json.Marshaler); ok {
+		return left
+	}
+	if err, ok := left.(error); ok {
+		return err.Error()
+	}
+	return left
+}
+
+func decodeLeft[A any](data []byte) (A, error) {
+	var left A
+	if reflect.TypeFor[A]() == reflect.TypeFor[error]() {
+		var msg string
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return left, err
+		}
+		return any(errors.New(msg)).(A), nil
+	}
+	return left, json.Unmarshal(data, &left)
+}
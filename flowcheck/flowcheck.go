@@ -0,0 +1,357 @@
+// Package flowcheck reports io.IO chains whose effects types don't match at
+// compile time. The effect input and output types are resolved from the type
+// arguments of ios effects returned by io and ios constructors.
+package flowcheck
+
+import (
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	modulePath = "github.com/mobilemindtech/go-io"
+	ioPath     = modulePath + "/io"
+	iosPath    = modulePath + "/io/ios"
+	typesPath  = modulePath + "/types"
+	unitPath   = modulePath + "/types/unit"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "goioflow",
+	Doc:      "check that each io.IO effect input type match the previous effect output type",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// flow input and output of an effect, by type argument index. last is the
+// last type argument, unit the Unit type, []0 a slice of the first type
+// argument and ? an input only known at runtime
+type flow struct {
+	in  string
+	out string
+}
+
+// effects flow of ios effects, must match TypeIn and TypeOut of each effect
+var effects = map[string]flow{
+	"IOPure":               {"unit", "0"},
+	"IOAndThan":            {"unit", "0"},
+	"IOAsSlice":            {"[]0", "[]0"},
+	"IOAttempt":            {"unit", "0"},
+	"IOAttemptAndThan":     {"unit", "0"},
+	"IOAttemptAuto":        {"unit", "0"},
+	"IOAttemptExec":        {"0", "0"},
+	"IOAttemptExecOrElse":  {"unit", "0"},
+	"IOAttemptFlatMap":     {"0", "1"},
+	"IOAttemptOrElse":      {"unit", "0"},
+	"IOAttemptThen":        {"0", "0"},
+	"IOCatchAll":           {"0", "0"},
+	"IODebug":              {"0", "0"},
+	"IOEnsure":             {"0", "0"},
+	"IOError":              {"unit", "unit"},
+	"IOFailIf":             {"0", "0"},
+	"IOFailIfEmpty":        {"0", "0"},
+	"IOFailWith":           {"0", "0"},
+	"IOFilter":             {"0", "0"},
+	"IOFlatMap":            {"?", "1"},
+	"IOFlatMap2":           {"unit", "last"},
+	"IOFlatMap3":           {"unit", "last"},
+	"IOFlatMap4":           {"unit", "last"},
+	"IOFlatMap5":           {"unit", "last"},
+	"IOFlatMap6":           {"unit", "last"},
+	"IOFlatMap7":           {"unit", "last"},
+	"IOFlatMap8":           {"unit", "last"},
+	"IOFlatMap9":           {"unit", "last"},
+	"IOFlatMap10":          {"unit", "last"},
+	"IOForeach":            {"0", "0"},
+	"IOLoadVar":            {"unit", "0"},
+	"IOMap":                {"0", "1"},
+	"IOMaybeFail":          {"0", "0"},
+	"IONohup":              {"unit", "0"},
+	"IOOr":                 {"0", "0"},
+	"IOOrElse":             {"0", "0"},
+	"IOPipe":               {"unit", "last"},
+	"IOPipe2":              {"unit", "last"},
+	"IOPipe3":              {"unit", "last"},
+	"IOPipe4":              {"unit", "last"},
+	"IOPipe5":              {"unit", "last"},
+	"IOPipe6":              {"unit", "last"},
+	"IOPipe7":              {"unit", "last"},
+	"IOPipe8":              {"unit", "last"},
+	"IOPipe9":              {"unit", "last"},
+	"IOPipe10":             {"unit", "last"},
+	"IORecover":            {"0", "0"},
+	"IOSliceAttempt":       {"[]0", "[]0"},
+	"IOSliceAttemptEach":   {"[]0", "[]0"},
+	"IOSliceAttemptOrElse": {"[]0", "[]0"},
+	"IOSliceFilter":        {"[]0", "[]0"},
+	"IOSliceFlatMap":       {"[]0", "[]1"},
+	"IOSliceForeach":       {"[]0", "[]0"},
+	"IOSliceMap":           {"[]0", "[]1"},
+	"IOSliceOr":            {"[]0", "[]0"},
+	"IOSliceOrElse":        {"[]0", "[]0"},
+	"IOTap":                {"0", "0"},
+	"IOThen":               {"0", "0"},
+	"IOValidate":           {"0", "0"},
+}
+
+// EffectFlow input and output of an ios effect in the table notation, used to
+// check the table against the effects TypeIn and TypeOut
+func EffectFlow(name string) (in string, out string, ok bool) {
+	fl, ok := effects[name]
+	return fl.in, fl.out, ok
+}
+
+// flatMapIO constructors of IOFlatMap that run an IO first, so take unit
+var flatMapIO = map[string]bool{"FlatMap1": true, "NewFlatMapIO": true}
+
+// effect resolved effect types. A nil type is unknown
+type effect struct {
+	name string
+	in   types.Type
+	out  types.Type
+	expr ast.Expr
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	// chains are checked once, from the outermost call
+	inner := map[ast.Expr]bool{}
+	var chains []*ast.CallExpr
+
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		if recv, _, ok := ioMethod(pass, call); ok {
+			inner[ast.Unparen(recv)] = true
+			chains = append(chains, call)
+		} else if isIOFunc(pass, call) {
+			chains = append(chains, call)
+		}
+	})
+
+	for _, call := range chains {
+		if !inner[call] {
+			check(pass, chainEffects(pass, call))
+		}
+	}
+	return nil, nil
+}
+
+// check report each effect whose input type don't match the previous
+// effect output type. Same rules of types.Validate
+func check(pass *analysis.Pass, effs []*effect) {
+	if len(effs) == 0 {
+		return
+	}
+	var lastOut types.Type
+	var lastName string
+
+	for _, eff := range effs {
+		switch {
+		case eff == nil:
+			lastOut = nil
+			continue
+		case lastOut == nil:
+			lastOut, lastName = eff.out, eff.name
+			continue
+		case eff.in != nil && isUnit(eff.in):
+			if eff.out != nil && !isUnit(eff.out) {
+				lastOut = eff.out
+			}
+			continue
+		case eff.in != nil && !types.Identical(eff.in, lastOut):
+			qualifier := types.RelativeTo(pass.Pkg)
+			pass.Reportf(eff.expr.Pos(), "IO %v expect type is %v, but last IO %v result type is %v",
+				eff.name, types.TypeString(eff.in, qualifier), lastName, types.TypeString(lastOut, qualifier))
+		}
+		lastOut, lastName = eff.out, eff.name
+	}
+}
+
+// chainEffects effects of a builder chain, in push order. Unknown effects are nil
+func chainEffects(pass *analysis.Pass, call *ast.CallExpr) []*effect {
+	var calls []*ast.CallExpr
+	expr := ast.Expr(call)
+	for {
+		c, ok := ast.Unparen(expr).(*ast.CallExpr)
+		if !ok {
+			break
+		}
+		if recv, builder, ok := ioMethod(pass, c); ok {
+			if builder {
+				calls = append(calls, c)
+			}
+			expr = recv
+			continue
+		}
+		if isIOFunc(pass, c) {
+			calls = append(calls, c)
+		}
+		break
+	}
+
+	var effs []*effect
+	for i := len(calls) - 1; i >= 0; i-- {
+		c := calls[i]
+		if c.Ellipsis.IsValid() {
+			effs = append(effs, nil)
+			continue
+		}
+		for _, arg := range c.Args {
+			effs = append(effs, resolve(pass, arg))
+		}
+	}
+	return effs
+}
+
+// ioMethod check if call is a *types.IO method that return the IO, like
+// builders that push effects and As, and return the receiver
+func ioMethod(pass *analysis.Pass, call *ast.CallExpr) (recv ast.Expr, builder bool, ok bool) {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil, false, false
+	}
+	selection := pass.TypesInfo.Selections[sel]
+	if selection == nil || selection.Kind() != types.MethodVal || !isNamed(selection.Recv(), typesPath, "IO") {
+		return nil, false, false
+	}
+	sig := selection.Type().(*types.Signature)
+	if sig.Results().Len() != 1 || !isNamed(sig.Results().At(0).Type(), typesPath, "IO") {
+		return nil, false, false
+	}
+	builder = sig.Params().Len() == 1 &&
+		(isNamed(sig.Params().At(0).Type(), typesPath, "IOEffect") ||
+			isNamed(sliceElem(sig.Params().At(0).Type()), typesPath, "IOEffect"))
+	return sel.X, builder, true
+}
+
+// isIOFunc check if call is io.IO[T](effects...)
+func isIOFunc(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := calledFunc(pass, call)
+	return fn != nil && fn.Pkg() != nil && fn.Pkg().Path() == ioPath && fn.Name() == "IO"
+}
+
+// resolve effect types from ios effect type arguments
+func resolve(pass *analysis.Pass, arg ast.Expr) *effect {
+	named := namedOf(pass.TypesInfo.TypeOf(arg))
+	if named == nil || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != iosPath {
+		return nil
+	}
+	name := named.Obj().Name()
+	fl, ok := effects[name]
+	if !ok {
+		return nil
+	}
+
+	args := named.TypeArgs()
+
+	eff := &effect{name: name, expr: arg, out: flowType(fl.out, args, pass)}
+	if fl.in == "?" {
+		if call, ok := ast.Unparen(arg).(*ast.CallExpr); ok {
+			if fn := calledFunc(pass, call); fn != nil && flatMapIO[fn.Name()] {
+				eff.in = unitType(pass)
+			} else if fn != nil {
+				eff.in = args.At(0)
+			}
+		}
+	} else {
+		eff.in = flowType(fl.in, args, pass)
+	}
+	return eff
+}
+
+func flowType(spec string, args *types.TypeList, pass *analysis.Pass) types.Type {
+	if spec == "unit" {
+		return unitType(pass)
+	}
+	elem, slice := strings.CutPrefix(spec, "[]")
+	i := args.Len() - 1
+	if elem != "last" {
+		i, _ = strconv.Atoi(elem)
+	}
+	if i < 0 || i >= args.Len() {
+		return nil
+	}
+	if slice {
+		return types.NewSlice(args.At(i))
+	}
+	return args.At(i)
+}
+
+// unitType *unit.Unit, from imported packages
+func unitType(pass *analysis.Pass) types.Type {
+	var find func(pkgs []*types.Package, seen map[*types.Package]bool) types.Type
+	find = func(pkgs []*types.Package, seen map[*types.Package]bool) types.Type {
+		for _, pkg := range pkgs {
+			if seen[pkg] {
+				continue
+			}
+			seen[pkg] = true
+			if pkg.Path() == unitPath {
+				if obj := pkg.Scope().Lookup("Unit"); obj != nil {
+					return types.NewPointer(obj.Type())
+				}
+			}
+			if typ := find(pkg.Imports(), seen); typ != nil {
+				return typ
+			}
+		}
+		return nil
+	}
+	return find([]*types.Package{pass.Pkg}, map[*types.Package]bool{})
+}
+
+func isUnit(typ types.Type) bool {
+	return isNamed(typ, unitPath, "Unit")
+}
+
+func calledFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	fun := ast.Unparen(call.Fun)
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+	var id *ast.Ident
+	switch f := fun.(type) {
+	case *ast.Ident:
+		id = f
+	case *ast.SelectorExpr:
+		id = f.Sel
+	default:
+		return nil
+	}
+	fn, _ := pass.TypesInfo.Uses[id].(*types.Func)
+	return fn
+}
+
+func namedOf(typ types.Type) *types.Named {
+	if typ == nil {
+		return nil
+	}
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, _ := typ.(*types.Named)
+	return named
+}
+
+func isNamed(typ types.Type, path, name string) bool {
+	named := namedOf(typ)
+	return named != nil && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == path && named.Obj().Name() == name
+}
+
+func sliceElem(typ types.Type) types.Type {
+	if slice, ok := typ.(*types.Slice); ok {
+		return slice.Elem()
+	}
+	return nil
+}
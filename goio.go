@@ -1 +1,3 @@
 package goio
+
+//go:generate go run ./internal/gen/cmd/genarity
@@ -0,0 +1,265 @@
+package http
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mobilemindtech/go-io/option"
+)
+
+// CacheEntry is a cached response
+type CacheEntry struct {
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+	Expires      time.Time   `json:"expires"`
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"last_modified,omitempty"`
+	NoCache      bool        `json:"no_cache,omitempty"`
+	// Vary request header values of the headers named by the response Vary header
+	Vary map[string]string `json:"vary,omitempty"`
+}
+
+// IsFresh check if entry can be used without revalidation
+func (this *CacheEntry) IsFresh(now time.Time) bool {
+	return !this.NoCache && now.Before(this.Expires)
+}
+
+// CanRevalidate check if entry has a validator to do a conditional request
+func (this *CacheEntry) CanRevalidate() bool {
+	return this.ETag != "" || this.LastModified != ""
+}
+
+// Matches check if request headers have the values of the entry Vary headers
+func (this *CacheEntry) Matches(reqHeader http.Header) bool {
+	for name, value := range this.Vary {
+		if reqHeader.Get(name) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// clone entry, so it can be changed without change the stored entry
+func (this *CacheEntry) clone() *CacheEntry {
+	entry := *this
+	entry.Header = this.Header.Clone()
+	return &entry
+}
+
+// CacheStore store cached responses by key
+type CacheStore interface {
+	Get(key string) *option.Option[*CacheEntry]
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
+
+// LRUCacheStore is a in memory store that remove least recently used entries
+type LRUCacheStore struct {
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+	mu       sync.Mutex
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+func NewLRUCacheStore(capacity int) *LRUCacheStore {
+	if capacity <= 0 {
+		panic("cache capacity should be > 0")
+	}
+	return &LRUCacheStore{
+		capacity: capacity,
+		items:    map[string]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+func (this *LRUCacheStore) Get(key string) *option.Option[*CacheEntry] {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if el, ok := this.items[key]; ok {
+		this.order.MoveToFront(el)
+		return option.Some(el.Value.(*lruItem).entry)
+	}
+	return option.None[*CacheEntry]()
+}
+
+func (this *LRUCacheStore) Set(key string, entry *CacheEntry) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if el, ok := this.items[key]; ok {
+		el.Value.(*lruItem).entry = entry
+		this.order.MoveToFront(el)
+		return
+	}
+	this.items[key] = this.order.PushFront(&lruItem{key: key, entry: entry})
+	if this.order.Len() > this.capacity {
+		last := this.order.Back()
+		this.order.Remove(last)
+		delete(this.items, last.Value.(*lruItem).key)
+	}
+}
+
+func (this *LRUCacheStore) Delete(key string) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if el, ok := this.items[key]; ok {
+		this.order.Remove(el)
+		delete(this.items, key)
+	}
+}
+
+func (this *LRUCacheStore) Len() int {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return this.order.Len()
+}
+
+// FileCacheStore store entries as JSON files on directory
+type FileCacheStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func NewFileCacheStore(dir string) *FileCacheStore {
+	return &FileCacheStore{dir: dir}
+}
+
+func (this *FileCacheStore) Get(key string) *option.Option[*CacheEntry] {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	data, err := os.ReadFile(this.path(key))
+	if err != nil {
+		return option.None[*CacheEntry]()
+	}
+	entry := &CacheEntry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return option.None[*CacheEntry]()
+	}
+	return option.Some(entry)
+}
+
+func (this *FileCacheStore) Set(key string, entry *CacheEntry) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(this.dir, 0o755); err != nil {
+		return
+	}
+	tmp := this.path(key) + ".tmp"
+	if os.WriteFile(tmp, data, 0o644) == nil {
+		os.Rename(tmp, this.path(key))
+	}
+}
+
+func (this *FileCacheStore) Delete(key string) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	os.Remove(this.path(key))
+}
+
+func (this *FileCacheStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(this.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// newCacheEntry create entry from request and response headers. Return None if response can not be
+// stored, like responses with Vary: * or responses to requests with Authorization that are not public
+func newCacheEntry(reqHeader http.Header, statusCode int, header http.Header, body []byte, now time.Time) *option.Option[*CacheEntry] {
+	directives := parseCacheControl(header.Get("Cache-Control"))
+
+	if _, ok := directives["no-store"]; ok {
+		return option.None[*CacheEntry]()
+	}
+
+	if _, public := directives["public"]; !public && reqHeader.Get("Authorization") != "" {
+		return option.None[*CacheEntry]()
+	}
+
+	vary := map[string]string{}
+	for _, value := range header.Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+			if name == "*" {
+				return option.None[*CacheEntry]()
+			}
+			if name != "" {
+				vary[name] = reqHeader.Get(name)
+			}
+		}
+	}
+
+	entry := &CacheEntry{
+		StatusCode:   statusCode,
+		Header:       header.Clone(),
+		Body:         body,
+		ETag:         header.Get("ETag"),
+		LastModified: header.Get("Last-Modified"),
+		Vary:         vary,
+		Expires:      now,
+	}
+	entry.refresh(header, now)
+
+	if !entry.IsFresh(now) && !entry.CanRevalidate() {
+		return option.None[*CacheEntry]()
+	}
+	return option.Some(entry)
+}
+
+// refresh update freshness from response headers, like a 304 response. Freshness
+// is kept when the response has not Cache-Control or Expires headers
+func (this *CacheEntry) refresh(header http.Header, now time.Time) {
+	if etag := header.Get("ETag"); etag != "" {
+		this.ETag = etag
+	}
+	if lastModified := header.Get("Last-Modified"); lastModified != "" {
+		this.LastModified = lastModified
+	}
+
+	if header.Get("Cache-Control") == "" && header.Get("Expires") == "" {
+		return
+	}
+
+	directives := parseCacheControl(header.Get("Cache-Control"))
+	_, this.NoCache = directives["no-cache"]
+	this.Expires = now
+
+	if maxAge, ok := directives["max-age"]; ok {
+		if secs, err := strconv.Atoi(maxAge); err == nil {
+			this.Expires = now.Add(time.Duration(secs) * time.Second)
+		}
+	} else if expires := header.Get("Expires"); expires != "" {
+		if t, err := http.ParseTime(expires); err == nil {
+			this.Expires = t
+		}
+	}
+}
+
+func parseCacheControl(value string) map[string]string {
+	directives := map[string]string{}
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, val, _ := strings.Cut(part, "=")
+		directives[strings.ToLower(name)] = strings.Trim(val, `"`)
+	}
+	return directives
+}
@@ -0,0 +1,112 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mobilemindtech/go-io/option"
+)
+
+// StatusError match http errors by status code with errors.Is
+type StatusError struct {
+	StatusCode int
+}
+
+func (this *StatusError) Error() string {
+	return fmt.Sprintf("http status %v %v", this.StatusCode, http.StatusText(this.StatusCode))
+}
+
+func (this *StatusError) Status() int {
+	return this.StatusCode
+}
+
+func (this *StatusError) Is(target error) bool {
+	if st, ok := target.(*StatusError); ok {
+		return st.StatusCode == this.StatusCode
+	}
+	return false
+}
+
+var (
+	BadRequest          = &StatusError{StatusCode: http.StatusBadRequest}
+	Unauthorized        = &StatusError{StatusCode: http.StatusUnauthorized}
+	Forbidden           = &StatusError{StatusCode: http.StatusForbidden}
+	NotFound            = &StatusError{StatusCode: http.StatusNotFound}
+	Conflict            = &StatusError{StatusCode: http.StatusConflict}
+	UnprocessableEntity = &StatusError{StatusCode: http.StatusUnprocessableEntity}
+	TooManyRequests     = &StatusError{StatusCode: http.StatusTooManyRequests}
+	InternalServerError = &StatusError{StatusCode: http.StatusInternalServerError}
+	ServiceUnavailable  = &StatusError{StatusCode: http.StatusServiceUnavailable}
+)
+
+// HttpError is a server response with a not success status code
+type HttpError[T any] struct {
+	EntityError *option.Option[T]
+	Message     string
+	StatusCode  int
+	Header      http.Header
+	RawBody     []byte
+}
+
+func NewHttpError[T any](statusCode int, header http.Header, body []byte, entity *option.Option[T]) *HttpError[T] {
+	return &HttpError[T]{
+		EntityError: entity,
+		Message:     fmt.Sprintf("server return http status %v", statusCode),
+		StatusCode:  statusCode,
+		Header:      header,
+		RawBody:     body,
+	}
+}
+
+func (this *HttpError[T]) Error() string {
+	return this.Message
+}
+
+func (this *HttpError[T]) Status() int {
+	return this.StatusCode
+}
+
+func (this *HttpError[T]) Body() string {
+	return string(this.RawBody)
+}
+
+// Is match errors.Is(err, http.NotFound) like status errors
+func (this *HttpError[T]) Is(target error) bool {
+	if st, ok := target.(*StatusError); ok {
+		return st.StatusCode == this.StatusCode
+	}
+	return false
+}
+
+// RequestError is a failure to encode, send, read or decode a request
+type RequestError struct {
+	Message string
+	Err     error
+}
+
+func NewRequestError(message string, err error) *RequestError {
+	return &RequestError{Message: message, Err: err}
+}
+
+func (this *RequestError) Error() string {
+	return fmt.Sprintf("%v: %v", this.Message, this.Err)
+}
+
+func (this *RequestError) Unwrap() error {
+	return this.Err
+}
+
+// IsStatus check if err is a http error with status code
+func IsStatus(err error, status int) bool {
+	return errors.Is(err, &StatusError{StatusCode: status})
+}
+
+// ErrorEntity get decoded error entity of a http error
+func ErrorEntity[T any](err error) *option.Option[T] {
+	var httpErr *HttpError[T]
+	if errors.As(err, &httpErr) && httpErr.EntityError != nil {
+		return httpErr.EntityError
+	}
+	return option.None[T]()
+}
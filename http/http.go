@@ -3,14 +3,20 @@ package http
 import (
 	"bytes"
 	"fmt"
+	"github.com/mobilemindtech/go-io/codec"
 	"github.com/mobilemindtech/go-io/json"
+	"github.com/mobilemindtech/go-io/logging"
 	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/ratelimit"
 	"github.com/mobilemindtech/go-io/result"
 	"io"
 	gio "io"
-	"log"
+	"log/slog"
 	"net/http"
 	"reflect"
+	"slices"
+	"strconv"
+	"time"
 )
 
 type HttpMethod string
@@ -28,15 +34,6 @@ var (
 	DefaultSuccessStatusCode = []int{200}
 )
 
-type HttpError[T any] struct {
-	EntityError *option.Option[T]
-	Message     string
-}
-
-func (this *HttpError[T]) Error() string {
-	return fmt.Sprintf(this.Message)
-}
-
 type HttpEncoder[T any] interface {
 	Encode(T) *result.Result[[]byte]
 }
@@ -51,6 +48,7 @@ type Response[T any, E any] struct {
 	RawBody     []byte            `json:"-"`
 	EntityError *option.Option[E] `json:"error_entity"`
 	Header      http.Header       `json:"header"`
+	FromCache   bool              `json:"from_cache"`
 }
 
 func (this *Response[T, E]) Body() string {
@@ -59,24 +57,23 @@ func (this *Response[T, E]) Body() string {
 
 func (this *Response[T, E]) BodyAsResult() *result.Result[T] {
 	if this.StatusCode != 200 {
-		return result.OfError[T](
-			&HttpError[E]{
-				Message:     fmt.Sprintf("server return http status %v", this.StatusCode),
-				EntityError: this.EntityError,
-			})
+		return result.OfError[T](this.HttpError())
 	}
 
 	if this.EntityBody.IsEmpty() {
-		return result.OfError[T](
-			&HttpError[E]{
-				Message:     "server return empty a body",
-				EntityError: this.EntityError,
-			})
+		err := this.HttpError()
+		err.Message = "server return empty a body"
+		return result.OfError[T](err)
 	}
 
 	return result.OfValue(this.EntityBody.Get())
 }
 
+// HttpError create a HttpError with response status, headers, body and error entity
+func (this *Response[T, E]) HttpError() *HttpError[E] {
+	return NewHttpError(this.StatusCode, this.Header, this.RawBody, this.EntityError)
+}
+
 type Responser struct {
 	StatusCode int
 	Header     http.Header
@@ -93,6 +90,14 @@ type HttpClient[Req, Resp, Err any] struct {
 	errorDecoder      HttpDecoder[Err]
 	headers           map[string]string
 	successStatusList []int
+	codecs            *codec.Registry
+	autoDecode        bool
+	failOnError       bool
+	statusDecoders    map[int]HttpDecoder[Err]
+	cache             *option.Option[CacheStore]
+	limiter           *option.Option[ratelimit.Limiter]
+	limiterKey        func(*http.Request) string
+	logger            *slog.Logger
 	Requester         *option.Option[DoRequest]
 }
 
@@ -100,6 +105,11 @@ func NewClient[Req, Resp, Err any]() *HttpClient[Req, Resp, Err] {
 	return &HttpClient[Req, Resp, Err]{
 		headers:           map[string]string{},
 		successStatusList: DefaultSuccessStatusCode,
+		codecs:            codec.Default,
+		statusDecoders:    map[int]HttpDecoder[Err]{},
+		cache:             option.None[CacheStore](),
+		limiter:           option.None[ratelimit.Limiter](),
+		limiterKey:        HostKey,
 		Requester:         option.None[DoRequest]()}
 }
 
@@ -108,6 +118,12 @@ func (this *HttpClient[Req, Resp, Err]) Debug() *HttpClient[Req, Resp, Err] {
 	return this
 }
 
+// WithLogger set client logger. By default the library logger is used
+func (this *HttpClient[Req, Resp, Err]) WithLogger(logger *slog.Logger) *HttpClient[Req, Resp, Err] {
+	this.logger = logger
+	return this
+}
+
 func (this *HttpClient[Req, Resp, Err]) WithRequester(f DoRequest) *HttpClient[Req, Resp, Err] {
 	this.Requester = option.Of(f)
 	return this
@@ -129,11 +145,87 @@ func (this *HttpClient[Req, Resp, Err]) AsJSON() *HttpClient[Req, Resp, Err] {
 	return this
 }
 
+func (this *HttpClient[Req, Resp, Err]) AsXML() *HttpClient[Req, Resp, Err] {
+	return this.As(codec.MimeXML)
+}
+
+// As use registered codec of content type to encode request and decode responses
+func (this *HttpClient[Req, Resp, Err]) As(contentType string) *HttpClient[Req, Resp, Err] {
+	c := this.codecs.Lookup(contentType).
+		OrPanic(fmt.Sprintf("codec not found for content type %v", contentType))
+	this.headers["Content-Type"] = c.ContentType()
+	this.headers["Accept"] = c.ContentType()
+	this.encoder = codec.NewEncoder[Req](c)
+	this.decoder = codec.NewDecoder[Resp](c)
+	this.errorDecoder = codec.NewDecoder[Err](c)
+	return this
+}
+
+// WithCodecs set codec registry used by As and AutoDecode. Default is codec.Default
+func (this *HttpClient[Req, Resp, Err]) WithCodecs(registry *codec.Registry) *HttpClient[Req, Resp, Err] {
+	this.codecs = registry
+	return this
+}
+
+// AutoDecode select response decoder from response Content-Type header. If content
+// type has not a registered codec, the configured decoder is used
+func (this *HttpClient[Req, Resp, Err]) AutoDecode() *HttpClient[Req, Resp, Err] {
+	this.autoDecode = true
+	return this
+}
+
+func (this *HttpClient[Req, Resp, Err]) SetEncoder(encoder HttpEncoder[Req]) *HttpClient[Req, Resp, Err] {
+	this.encoder = encoder
+	return this
+}
+
+func (this *HttpClient[Req, Resp, Err]) SetDecoder(decoder HttpDecoder[Resp]) *HttpClient[Req, Resp, Err] {
+	this.decoder = decoder
+	return this
+}
+
 func (this *HttpClient[Req, Resp, Err]) SetErrorDecoder(decoder HttpDecoder[Err]) *HttpClient[Req, Resp, Err] {
 	this.errorDecoder = decoder
 	return this
 }
 
+// WithStatusDecoder set error decoder used when server return status code
+func (this *HttpClient[Req, Resp, Err]) WithStatusDecoder(status int, decoder HttpDecoder[Err]) *HttpClient[Req, Resp, Err] {
+	this.statusDecoders[status] = decoder
+	return this
+}
+
+// FailOnError return a *HttpError failure when server return a non 2xx status code
+// that is not in success status list
+func (this *HttpClient[Req, Resp, Err]) FailOnError() *HttpClient[Req, Resp, Err] {
+	this.failOnError = true
+	return this
+}
+
+// WithCache cache GET responses honouring Cache-Control, ETag and Last-Modified headers
+func (this *HttpClient[Req, Resp, Err]) WithCache(store CacheStore) *HttpClient[Req, Resp, Err] {
+	this.cache = option.Of(store)
+	return this
+}
+
+// WithRateLimit limit requests per host with a token bucket of rps and burst
+func (this *HttpClient[Req, Resp, Err]) WithRateLimit(rps float64, burst int) *HttpClient[Req, Resp, Err] {
+	return this.WithLimiter(ratelimit.NewTokenBucket(rps, burst))
+}
+
+// WithLimiter wait limiter before each request. 429 and 503 responses with
+// Retry-After header pause the limiter key
+func (this *HttpClient[Req, Resp, Err]) WithLimiter(limiter ratelimit.Limiter) *HttpClient[Req, Resp, Err] {
+	this.limiter = option.Of(limiter)
+	return this
+}
+
+// RateLimitKey set limiter key of request. Default is HostKey
+func (this *HttpClient[Req, Resp, Err]) RateLimitKey(f func(*http.Request) string) *HttpClient[Req, Resp, Err] {
+	this.limiterKey = f
+	return this
+}
+
 func (this *HttpClient[Req, Resp, Err]) Header(name string, value string) *HttpClient[Req, Resp, Err] {
 	this.headers[name] = value
 	return this
@@ -142,7 +234,7 @@ func (this *HttpClient[Req, Resp, Err]) Header(name string, value string) *HttpC
 func (this *HttpClient[Req, Resp, Err]) Headers(vals ...string) *HttpClient[Req, Resp, Err] {
 
 	if len(vals)%2 != 0 {
-		log.Printf("headers should be even\n")
+		logging.Or(this.logger).Warn("headers should be even")
 	}
 
 	var name string
@@ -200,8 +292,10 @@ func (this *HttpClient[Req, Resp, Err]) Request(url string, method HttpMethod, d
 		}
 	}
 
+	logger := logging.Or(this.logger).With("url", url, "method", method)
+
 	if this.debug {
-		log.Printf("URL %v, METHOD = %v\n", url, method)
+		logger.Info("request")
 	}
 
 	var payload *bytes.Buffer
@@ -210,14 +304,14 @@ func (this *HttpClient[Req, Resp, Err]) Request(url string, method HttpMethod, d
 		if this.encoder != nil {
 			res := this.encoder.Encode(data.Get())
 			if res.IsError() {
-				return result.OfError[*Response[Resp, Err]](fmt.Errorf("payload encode error: %v", res.Failure().Error()))
+				return result.OfError[*Response[Resp, Err]](NewRequestError("payload encode error", res.Failure()))
 			}
 			payload = bytes.NewBuffer(res.Get())
 		} else {
 			payload = bytes.NewBufferString(data.GetValue().(string))
 		}
 		if this.debug {
-			log.Printf("PAYLOAD = %v\n", payload.String())
+			logger.Info("request payload", "payload", payload.String())
 		}
 		req, err = http.NewRequest(string(method), url, payload)
 	} else {
@@ -225,17 +319,38 @@ func (this *HttpClient[Req, Resp, Err]) Request(url string, method HttpMethod, d
 	}
 
 	if err != nil {
-		return result.OfError[*Response[Resp, Err]](fmt.Errorf("create request error: %v", err))
+		return result.OfError[*Response[Resp, Err]](NewRequestError("create request error", err))
 	}
 
 	if this.debug {
-		log.Printf("HEADERS = %v\n", this.headers)
+		logger.Info("request headers", "headers", this.headers)
 	}
 
 	for k, v := range this.headers {
 		req.Header.Add(k, v)
 	}
 
+	cached := this.cacheLookup(method, url, req.Header)
+
+	if cached.NonEmpty() {
+		entry := cached.Get()
+		if entry.IsFresh(time.Now()) {
+			return this.cachedResponse(entry)
+		}
+		if entry.ETag != "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			req.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	if this.limiter.NonEmpty() {
+		if err := this.limiter.Get().Wait(req.Context(), this.limiterKey(req)); err != nil {
+			return result.OfError[*Response[Resp, Err]](NewRequestError("rate limit error", err))
+		}
+	}
+
 	resResult := option.Or(
 		option.Map(this.Requester,
 			func(f DoRequest) *result.Result[*Responser] {
@@ -254,88 +369,198 @@ func (this *HttpClient[Req, Resp, Err]) Request(url string, method HttpMethod, d
 		})
 
 	if resResult.HasError() {
-		return result.OfError[*Response[Resp, Err]](resResult.Failure())
+		return result.OfError[*Response[Resp, Err]](NewRequestError("request error", resResult.Failure()))
 	}
 
 	res := resResult.Get()
 
 	defer res.Body.Close()
 
+	if this.limiter.NonEmpty() && (res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable) {
+		if retryAfter := ParseRetryAfter(res.Header.Get("Retry-After")); retryAfter.NonEmpty() {
+			this.limiter.Get().Pause(this.limiterKey(req), retryAfter.Get())
+		}
+	}
+
 	body, err := gio.ReadAll(res.Body)
 
 	if err != nil {
-		return result.OfError[*Response[Resp, Err]](fmt.Errorf("read reponse error: %v", err))
+		return result.OfError[*Response[Resp, Err]](NewRequestError("read reponse error", err))
 	}
 
 	if this.debug {
-		log.Printf("RESPONSE STATUS CODE %V, BODY = %v\n", res.StatusCode, string(body))
+		logger.Info("response", "status_code", res.StatusCode, "body", string(body))
 	}
 
-	for _, status := range this.successStatusList {
-
-		if status == res.StatusCode {
-			if this.decoder != nil {
+	if res.StatusCode == http.StatusNotModified && cached.NonEmpty() {
+		entry := cached.Get().clone()
+		entry.refresh(res.Header, time.Now())
+		this.cacheStore(method, url, entry)
+		return this.cachedResponse(entry)
+	}
 
-				decoded := this.decoder.Decode(body)
+	decoder, errorDecoder := this.responseDecoders(res.StatusCode, res.Header)
 
-				if decoded.IsError() {
-					return result.OfError[*Response[Resp, Err]](
-						fmt.Errorf("response decode error: %v", decoded.Failure().Error()))
-				} else {
-					return result.OfValue(&Response[Resp, Err]{
-						EntityBody:  option.Of(decoded.Get()),
-						StatusCode:  res.StatusCode,
-						RawBody:     body,
-						EntityError: option.None[Err](),
-						Header:      res.Header,
-					})
-				}
+	if this.isSuccess(res.StatusCode) {
+		if decoder != nil {
 
-			} else if reflect.TypeFor[Resp]().Kind() == reflect.String {
-
-				str := reflect.ValueOf(string(body)).Interface().(Resp)
-				return result.OfValue(&Response[Resp, Err]{
-					EntityBody:  option.Of(str),
-					StatusCode:  res.StatusCode,
-					RawBody:     body,
-					EntityError: option.None[Err](),
-					Header:      res.Header,
-				})
+			decoded := decoder.Decode(body)
 
+			if decoded.IsError() {
+				return result.OfError[*Response[Resp, Err]](
+					NewRequestError("response decode error", decoded.Failure()))
 			} else {
+				this.cacheResponse(method, url, req.Header, res, body)
 				return result.OfValue(&Response[Resp, Err]{
-					EntityBody:  option.None[Resp](),
+					EntityBody:  option.Of(decoded.Get()),
 					StatusCode:  res.StatusCode,
 					RawBody:     body,
 					EntityError: option.None[Err](),
 					Header:      res.Header,
 				})
 			}
-		}
-	}
 
-	if this.errorDecoder != nil {
-		decoded := this.errorDecoder.Decode(body)
+		} else if reflect.TypeFor[Resp]().Kind() == reflect.String {
+
+			str := reflect.ValueOf(string(body)).Interface().(Resp)
+			this.cacheResponse(method, url, req.Header, res, body)
+			return result.OfValue(&Response[Resp, Err]{
+				EntityBody:  option.Of(str),
+				StatusCode:  res.StatusCode,
+				RawBody:     body,
+				EntityError: option.None[Err](),
+				Header:      res.Header,
+			})
 
-		if decoded.IsError() {
-			return result.OfError[*Response[Resp, Err]](
-				fmt.Errorf("decode error response error: %v", decoded.Failure().Error()))
 		} else {
 			return result.OfValue(&Response[Resp, Err]{
 				EntityBody:  option.None[Resp](),
-				EntityError: option.Of(decoded.Get()),
-				RawBody:     body,
 				StatusCode:  res.StatusCode,
+				RawBody:     body,
+				EntityError: option.None[Err](),
 				Header:      res.Header,
 			})
 		}
 	}
 
+	entityError := option.None[Err]()
+
+	if errorDecoder != nil {
+		decoded := errorDecoder.Decode(body)
+
+		if decoded.IsOk() {
+			entityError = option.Of(decoded.Get())
+		} else if !this.failOnError {
+			return result.OfError[*Response[Resp, Err]](
+				NewRequestError("decode error response error", decoded.Failure()))
+		}
+	}
+
+	if this.failOnError {
+		return result.OfError[*Response[Resp, Err]](
+			NewHttpError(res.StatusCode, res.Header, body, entityError))
+	}
+
 	return result.OfValue(&Response[Resp, Err]{
 		EntityBody:  option.None[Resp](),
-		EntityError: option.None[Err](),
+		EntityError: entityError,
 		RawBody:     body,
 		StatusCode:  res.StatusCode,
 		Header:      res.Header,
 	})
 }
+
+func (this *HttpClient[Req, Resp, Err]) isSuccess(statusCode int) bool {
+	if slices.Contains(this.successStatusList, statusCode) {
+		return true
+	}
+	return this.failOnError && statusCode >= 200 && statusCode < 300
+}
+
+func (this *HttpClient[Req, Resp, Err]) responseDecoders(statusCode int, header http.Header) (HttpDecoder[Resp], HttpDecoder[Err]) {
+	decoder, errorDecoder := this.decoder, this.errorDecoder
+	if this.autoDecode {
+		if c := this.codecs.Lookup(header.Get("Content-Type")); c.NonEmpty() {
+			decoder, errorDecoder = codec.NewDecoder[Resp](c.Get()), codec.NewDecoder[Err](c.Get())
+		}
+	}
+	if statusDecoder, ok := this.statusDecoders[statusCode]; ok {
+		errorDecoder = statusDecoder
+	}
+	return decoder, errorDecoder
+}
+
+func (this *HttpClient[Req, Resp, Err]) cacheLookup(method HttpMethod, url string, reqHeader http.Header) *option.Option[*CacheEntry] {
+	if method != GET || this.cache.IsEmpty() {
+		return option.None[*CacheEntry]()
+	}
+	return option.Filter(this.cache.Get().Get(cacheKey(method, url)), func(entry *CacheEntry) bool {
+		return entry.Matches(reqHeader)
+	})
+}
+
+func (this *HttpClient[Req, Resp, Err]) cacheStore(method HttpMethod, url string, entry *CacheEntry) {
+	this.cache.Get().Set(cacheKey(method, url), entry)
+}
+
+func (this *HttpClient[Req, Resp, Err]) cacheResponse(method HttpMethod, url string, reqHeader http.Header, res *Responser, body []byte) {
+	if method != GET || this.cache.IsEmpty() {
+		return
+	}
+	entry := newCacheEntry(reqHeader, res.StatusCode, res.Header, body, time.Now())
+	if entry.NonEmpty() {
+		this.cacheStore(method, url, entry.Get())
+	} else {
+		this.cache.Get().Delete(cacheKey(method, url))
+	}
+}
+
+// cachedResponse create response from cache entry. The body is decoded on each hit,
+// so callers don't share the entity. The entry is not changed
+func (this *HttpClient[Req, Resp, Err]) cachedResponse(entry *CacheEntry) *result.Result[*Response[Resp, Err]] {
+	var entity Resp
+
+	decoder, _ := this.responseDecoders(entry.StatusCode, entry.Header)
+	if decoder != nil {
+		decoded := decoder.Decode(entry.Body)
+		if decoded.IsError() {
+			return result.OfError[*Response[Resp, Err]](
+				NewRequestError("cached response decode error", decoded.Failure()))
+		}
+		entity = decoded.Get()
+	} else if reflect.TypeFor[Resp]().Kind() == reflect.String {
+		entity = reflect.ValueOf(string(entry.Body)).Interface().(Resp)
+	}
+
+	return result.OfValue(&Response[Resp, Err]{
+		EntityBody:  option.Of(entity),
+		StatusCode:  entry.StatusCode,
+		RawBody:     entry.Body,
+		EntityError: option.None[Err](),
+		Header:      entry.Header,
+		FromCache:   true,
+	})
+}
+
+func cacheKey(method HttpMethod, url string) string {
+	return fmt.Sprintf("%v %v", method, url)
+}
+
+// HostKey is a rate limit key by request host
+func HostKey(req *http.Request) string {
+	return req.URL.Host
+}
+
+// ParseRetryAfter parse Retry-After header as seconds or http date
+func ParseRetryAfter(value string) *option.Option[time.Duration] {
+	if value == "" {
+		return option.None[time.Duration]()
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return option.Some(time.Duration(secs) * time.Second)
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return option.Some(max(time.Until(t), 0))
+	}
+	return option.None[time.Duration]()
+}
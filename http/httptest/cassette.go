@@ -0,0 +1,94 @@
+package httptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/mobilemindtech/go-io/http"
+	"github.com/mobilemindtech/go-io/option"
+)
+
+// RecordedRequest is a request saved on cassette
+type RecordedRequest struct {
+	Method string         `json:"method"`
+	URL    string         `json:"url"`
+	Header nethttp.Header `json:"header,omitempty"`
+	Body   string         `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response saved on cassette
+type RecordedResponse struct {
+	StatusCode int            `json:"status_code"`
+	Header     nethttp.Header `json:"header,omitempty"`
+	Body       string         `json:"body,omitempty"`
+}
+
+// Interaction is a request and response exchange
+type Interaction struct {
+	Request  *RecordedRequest  `json:"request"`
+	Response *RecordedResponse `json:"response"`
+}
+
+// Cassette is a list of interactions stored as JSON golden file
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// LoadCassette read cassette from JSON file
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, err
+	}
+	return cassette, nil
+}
+
+// Save write cassette to JSON file, creating parent directories
+func (this *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(this, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// NewRecordedRequest copy request data. The request body is restored to be read again
+func NewRecordedRequest(req *nethttp.Request) (*RecordedRequest, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return &RecordedRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header.Clone(),
+		Body:   string(body),
+	}, nil
+}
+
+// Responser create a http.Responser with recorded response data
+func (this *RecordedResponse) Responser() *http.Responser {
+	return &http.Responser{
+		StatusCode: this.StatusCode,
+		Header:     this.Header.Clone(),
+		Body:       io.NopCloser(bytes.NewBufferString(this.Body)),
+		Raw:        option.None[*nethttp.Response](),
+	}
+}
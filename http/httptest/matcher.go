@@ -0,0 +1,67 @@
+package httptest
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+)
+
+// Matcher check if a request match a recorded request
+type Matcher func(req *RecordedRequest, recorded *RecordedRequest) bool
+
+// DefaultMatcher match method and URL
+var DefaultMatcher = MatchAll(MatchMethod, MatchURL)
+
+// MatchAll match when all matchers match
+func MatchAll(matchers ...Matcher) Matcher {
+	return func(req *RecordedRequest, recorded *RecordedRequest) bool {
+		for _, m := range matchers {
+			if !m(req, recorded) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// MatchMethod match request method
+func MatchMethod(req *RecordedRequest, recorded *RecordedRequest) bool {
+	return req.Method == recorded.Method
+}
+
+// MatchURL match request URL, ignoring query params order
+func MatchURL(req *RecordedRequest, recorded *RecordedRequest) bool {
+	a, errA := url.Parse(req.URL)
+	b, errB := url.Parse(recorded.URL)
+	if errA != nil || errB != nil {
+		return req.URL == recorded.URL
+	}
+	return a.Scheme == b.Scheme &&
+		a.Host == b.Host &&
+		a.Path == b.Path &&
+		reflect.DeepEqual(a.Query(), b.Query())
+}
+
+// MatchBody match request body. JSON bodies are compared by value
+func MatchBody(req *RecordedRequest, recorded *RecordedRequest) bool {
+	if req.Body == recorded.Body {
+		return true
+	}
+	var a, b any
+	if json.Unmarshal([]byte(req.Body), &a) != nil || json.Unmarshal([]byte(recorded.Body), &b) != nil {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// MatchHeaders match values of headers names
+func MatchHeaders(names ...string) Matcher {
+	return func(req *RecordedRequest, recorded *RecordedRequest) bool {
+		for _, name := range names {
+			if !reflect.DeepEqual(req.Header.Values(name), recorded.Header.Values(name)) {
+				return false
+			}
+		}
+		return true
+	}
+}
@@ -0,0 +1,193 @@
+package httptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/http"
+	"github.com/mobilemindtech/go-io/result"
+)
+
+// Expectation is a expected request and the response to return
+type Expectation struct {
+	method   http.HttpMethod
+	url      string
+	matchers []Matcher
+	times    int
+	calls    int
+	response *RecordedResponse
+}
+
+// WithBody expect request body. JSON bodies are compared by value
+func (this *Expectation) WithBody(body string) *Expectation {
+	this.matchers = append(this.matchers, func(req *RecordedRequest, _ *RecordedRequest) bool {
+		return MatchBody(req, &RecordedRequest{Body: body})
+	})
+	return this
+}
+
+// WithHeader expect request header value
+func (this *Expectation) WithHeader(name string, value string) *Expectation {
+	this.matchers = append(this.matchers, func(req *RecordedRequest, _ *RecordedRequest) bool {
+		return req.Header.Get(name) == value
+	})
+	return this
+}
+
+// Match add a custom request matcher
+func (this *Expectation) Match(matcher Matcher) *Expectation {
+	this.matchers = append(this.matchers, matcher)
+	return this
+}
+
+// Times expected calls count. Default is 1
+func (this *Expectation) Times(n int) *Expectation {
+	this.times = n
+	return this
+}
+
+// Respond return status code and body
+func (this *Expectation) Respond(statusCode int, body string) *Expectation {
+	this.response.StatusCode = statusCode
+	this.response.Body = body
+	return this
+}
+
+// RespondJSON return status code and value encoded as JSON
+func (this *Expectation) RespondJSON(statusCode int, value any) *Expectation {
+	data, err := json.Marshal(value)
+	if err != nil {
+		panic(fmt.Sprintf("encode mock response: %v", err))
+	}
+	this.response.Header.Set("Content-Type", "application/json")
+	return this.Respond(statusCode, string(data))
+}
+
+// RespondHeader add response header
+func (this *Expectation) RespondHeader(name string, value string) *Expectation {
+	this.response.Header.Add(name, value)
+	return this
+}
+
+func (this *Expectation) String() string {
+	return fmt.Sprintf("%v %v", this.method, this.url)
+}
+
+func (this *Expectation) matches(req *RecordedRequest) bool {
+	if string(this.method) != req.Method || !this.matchURL(req.URL) {
+		return false
+	}
+	for _, m := range this.matchers {
+		if !m(req, nil) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchURL compare full URL, or path and query when expectation has no host
+func (this *Expectation) matchURL(rawURL string) bool {
+	if strings.HasPrefix(this.url, "/") {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return false
+		}
+		rawURL = u.RequestURI()
+	}
+	return MatchURL(&RecordedRequest{URL: rawURL}, &RecordedRequest{URL: this.url})
+}
+
+// MockServer answer requests with programmed expectations
+type MockServer struct {
+	t            testing.TB
+	expectations []*Expectation
+	mu           sync.Mutex
+}
+
+// NewMockServer create a mock server. Expectations are verified on test cleanup
+func NewMockServer(t testing.TB) *MockServer {
+	this := &MockServer{t: t}
+	t.Cleanup(this.Verify)
+	return this
+}
+
+// Expect add an expected request. URL can be absolute or only path and query
+func (this *MockServer) Expect(method http.HttpMethod, url string) *Expectation {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	exp := &Expectation{
+		method:   method,
+		url:      url,
+		times:    1,
+		response: &RecordedResponse{StatusCode: nethttp.StatusOK, Header: nethttp.Header{}},
+	}
+	this.expectations = append(this.expectations, exp)
+	return exp
+}
+
+// Requester to use with http.HttpClient.WithRequester
+func (this *MockServer) Requester() http.DoRequest {
+	return this.Do
+}
+
+// Do answer request with the first matching expectation with pending calls
+func (this *MockServer) Do(req *nethttp.Request) *result.Result[*http.Responser] {
+	recorded, err := NewRecordedRequest(req)
+	if err != nil {
+		return result.OfError[*http.Responser](err)
+	}
+	return result.Map(this.handle(recorded), func(res *RecordedResponse) *http.Responser {
+		return res.Responser()
+	})
+}
+
+// ServeHTTP allow use mock server with net/http/httptest.NewServer
+func (this *MockServer) ServeHTTP(w nethttp.ResponseWriter, req *nethttp.Request) {
+	recorded, err := NewRecordedRequest(req)
+	if err != nil {
+		nethttp.Error(w, err.Error(), nethttp.StatusBadRequest)
+		return
+	}
+	res := this.handle(recorded)
+	if res.IsError() {
+		nethttp.Error(w, res.Failure().Error(), nethttp.StatusNotImplemented)
+		return
+	}
+	for k, v := range res.Get().Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(res.Get().StatusCode)
+	io.WriteString(w, res.Get().Body)
+}
+
+// Verify fail test if any expectation was not called the expected times
+func (this *MockServer) Verify() {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	for _, exp := range this.expectations {
+		if exp.calls != exp.times {
+			this.t.Errorf("expected %v to be called %v times, but was called %v times",
+				exp, exp.times, exp.calls)
+		}
+	}
+}
+
+func (this *MockServer) handle(req *RecordedRequest) *result.Result[*RecordedResponse] {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	for _, exp := range this.expectations {
+		if exp.calls < exp.times && exp.matches(req) {
+			exp.calls++
+			return result.OfValue(exp.response)
+		}
+	}
+	err := &UnexpectedRequestError{Request: req}
+	this.t.Errorf("%v", err)
+	return result.OfError[*RecordedResponse](err)
+}
@@ -0,0 +1,197 @@
+package httptest
+
+import (
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/http"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+)
+
+type Mode int
+
+const (
+	// ModeAuto replay cassette if file exists, otherwise record
+	ModeAuto Mode = iota
+	// ModeRecord do real requests and save cassette
+	ModeRecord
+	// ModeReplay only replay cassette interactions
+	ModeReplay
+)
+
+// UnexpectedRequestError is returned when a request has no matching interaction
+type UnexpectedRequestError struct {
+	Request *RecordedRequest
+}
+
+func (this *UnexpectedRequestError) Error() string {
+	return fmt.Sprintf("unexpected request %v %v", this.Request.Method, this.Request.URL)
+}
+
+// Recorder record http exchanges into a JSON cassette and replay them
+type Recorder struct {
+	t        testing.TB
+	path     string
+	mode     Mode
+	matcher  Matcher
+	redact   []string
+	next     http.DoRequest
+	cassette *Cassette
+	used     []bool
+	loaded   bool
+	mu       sync.Mutex
+}
+
+// NewRecorder create recorder to cassette file path. Cassette is saved on test cleanup when recording
+func NewRecorder(t testing.TB, path string) *Recorder {
+	this := &Recorder{
+		t:        t,
+		path:     path,
+		matcher:  DefaultMatcher,
+		next:     doRequest,
+		cassette: &Cassette{},
+	}
+	t.Cleanup(func() {
+		if err := this.Stop(); err != nil {
+			t.Errorf("save cassette %v: %v", path, err)
+		}
+	})
+	return this
+}
+
+func (this *Recorder) Mode(mode Mode) *Recorder {
+	this.mode = mode
+	return this
+}
+
+func (this *Recorder) WithMatcher(matcher Matcher) *Recorder {
+	this.matcher = matcher
+	return this
+}
+
+// WithRequester set requester used to record. Default is net/http client
+func (this *Recorder) WithRequester(f http.DoRequest) *Recorder {
+	this.next = f
+	return this
+}
+
+// Redact replace headers values by "REDACTED" on recorded requests
+func (this *Recorder) Redact(headers ...string) *Recorder {
+	this.redact = append(this.redact, headers...)
+	return this
+}
+
+// IsRecording check if recorder do real requests
+func (this *Recorder) IsRecording() bool {
+	switch this.mode {
+	case ModeRecord:
+		return true
+	case ModeReplay:
+		return false
+	default:
+		_, err := os.Stat(this.path)
+		return os.IsNotExist(err)
+	}
+}
+
+// Requester to use with http.HttpClient.WithRequester
+func (this *Recorder) Requester() http.DoRequest {
+	return this.Do
+}
+
+// Do record or replay request
+func (this *Recorder) Do(req *nethttp.Request) *result.Result[*http.Responser] {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+
+	recorded, err := NewRecordedRequest(req)
+	if err != nil {
+		return result.OfError[*http.Responser](err)
+	}
+
+	if this.IsRecording() {
+		return this.record(req, recorded)
+	}
+	return this.replay(recorded)
+}
+
+// Stop save cassette when recording
+func (this *Recorder) Stop() error {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if this.IsRecording() && len(this.cassette.Interactions) > 0 {
+		return this.cassette.Save(this.path)
+	}
+	return nil
+}
+
+func (this *Recorder) record(req *nethttp.Request, recorded *RecordedRequest) *result.Result[*http.Responser] {
+	res := this.next(req)
+	if res.IsError() {
+		return res
+	}
+
+	responser := res.Get()
+	body, err := io.ReadAll(responser.Body)
+	responser.Body.Close()
+	if err != nil {
+		return result.OfError[*http.Responser](err)
+	}
+
+	for _, name := range this.redact {
+		if recorded.Header.Get(name) != "" {
+			recorded.Header.Set(name, "REDACTED")
+		}
+	}
+
+	response := &RecordedResponse{
+		StatusCode: responser.StatusCode,
+		Header:     responser.Header,
+		Body:       string(body),
+	}
+	this.cassette.Interactions = append(this.cassette.Interactions,
+		&Interaction{Request: recorded, Response: response})
+	return result.OfValue(response.Responser())
+}
+
+func (this *Recorder) replay(recorded *RecordedRequest) *result.Result[*http.Responser] {
+	if !this.loaded {
+		cassette, err := LoadCassette(this.path)
+		if err != nil {
+			this.t.Errorf("load cassette %v: %v", this.path, err)
+			return result.OfError[*http.Responser](err)
+		}
+		this.cassette = cassette
+		this.used = make([]bool, len(cassette.Interactions))
+		this.loaded = true
+	}
+
+	for i, interaction := range this.cassette.Interactions {
+		if !this.used[i] && this.matcher(recorded, interaction.Request) {
+			this.used[i] = true
+			return result.OfValue(interaction.Response.Responser())
+		}
+	}
+
+	err := &UnexpectedRequestError{Request: recorded}
+	this.t.Errorf("%v", err)
+	return result.OfError[*http.Responser](err)
+}
+
+func doRequest(req *nethttp.Request) *result.Result[*http.Responser] {
+	res, err := new(nethttp.Client).Do(req)
+	if err != nil {
+		return result.OfError[*http.Responser](err)
+	}
+	return result.OfValue(&http.Responser{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       res.Body,
+		Raw:        option.Of(res),
+	})
+}
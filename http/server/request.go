@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	gio "io"
+	nethttp "net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/mobilemindtech/go-io/json"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+)
+
+type Request struct {
+	Raw *nethttp.Request
+}
+
+func NewRequest(raw *nethttp.Request) *Request {
+	return &Request{Raw: raw}
+}
+
+func (this *Request) Context() context.Context {
+	return this.Raw.Context()
+}
+
+func (this *Request) Header(name string) string {
+	return this.Raw.Header.Get(name)
+}
+
+func (this *Request) PathValue(name string) *option.Option[string] {
+	if val := this.Raw.PathValue(name); len(val) > 0 {
+		return option.Some(val)
+	}
+	return option.None[string]()
+}
+
+func (this *Request) QueryValue(name string) *option.Option[string] {
+	if this.Raw.URL.Query().Has(name) {
+		return option.Some(this.Raw.URL.Query().Get(name))
+	}
+	return option.None[string]()
+}
+
+// PathParam decode a required path param
+func PathParam[T any](req *Request, name string) *result.Result[T] {
+	val := req.PathValue(name)
+	if val.IsEmpty() {
+		return result.OfError[T](BadRequest(fmt.Sprintf("path param %v is required", name)))
+	}
+	return parseParam[T]("path param", name, val.Get())
+}
+
+// Query decode a required query param
+func Query[T any](req *Request, name string) *result.Result[T] {
+	val := req.QueryValue(name)
+	if val.IsEmpty() {
+		return result.OfError[T](BadRequest(fmt.Sprintf("query param %v is required", name)))
+	}
+	return parseParam[T]("query param", name, val.Get())
+}
+
+// QueryOption decode an optional query param
+func QueryOption[T any](req *Request, name string) *result.Result[*option.Option[T]] {
+	val := req.QueryValue(name)
+	if val.IsEmpty() {
+		return result.OfNone[T]()
+	}
+	return result.MapToResultOption(parseParam[T]("query param", name, val.Get()))
+}
+
+// Body decode JSON request body
+func Body[T any](req *Request) *result.Result[T] {
+	if req.Raw.Body == nil {
+		return result.OfError[T](BadRequest("request body is required"))
+	}
+	defer req.Raw.Body.Close()
+	data, err := gio.ReadAll(req.Raw.Body)
+	if err != nil {
+		return result.OfError[T](BadRequest(fmt.Sprintf("read request body error: %v", err)))
+	}
+	if len(data) == 0 {
+		return result.OfError[T](BadRequest("request body is required"))
+	}
+	return json.Decode[T](data).
+		ReplaceErrror(func(err error) error {
+			return BadRequest(fmt.Sprintf("request body decode error: %v", err))
+		})
+}
+
+func PathParamIO[T any](req *Request, name string) *rio.IO[T] {
+	return rio.Attempt(func() *result.Result[T] {
+		return PathParam[T](req, name)
+	})
+}
+
+func QueryIO[T any](req *Request, name string) *rio.IO[T] {
+	return rio.Attempt(func() *result.Result[T] {
+		return Query[T](req, name)
+	})
+}
+
+func BodyIO[T any](req *Request) *rio.IO[T] {
+	return rio.Attempt(func() *result.Result[T] {
+		return Body[T](req)
+	})
+}
+
+func parseParam[T any](kind string, name string, raw string) *result.Result[T] {
+	typOf := reflect.TypeFor[T]()
+	val := reflect.New(typOf).Elem()
+	var err error
+
+	switch typOf.Kind() {
+	case reflect.String:
+		val.SetString(raw)
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(raw)
+		val.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		i, err = strconv.ParseInt(raw, 10, typOf.Bits())
+		val.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		u, err = strconv.ParseUint(raw, 10, typOf.Bits())
+		val.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, err = strconv.ParseFloat(raw, typOf.Bits())
+		val.SetFloat(f)
+	default:
+		return json.Decode[T]([]byte(raw)).
+			ReplaceErrror(func(err error) error {
+				return BadRequest(fmt.Sprintf("invalid %v %v: %v", kind, name, err))
+			})
+	}
+
+	if err != nil {
+		return result.OfError[T](BadRequest(fmt.Sprintf("invalid %v %v: %v", kind, name, err)))
+	}
+
+	return result.OfValue(val.Interface().(T))
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	nethttp "net/http"
+
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/rio"
+)
+
+type ServerResponse[T any] struct {
+	StatusCode int
+	Header     nethttp.Header
+	Body       *option.Option[T]
+}
+
+func NewResponse[T any](status int, body T) *ServerResponse[T] {
+	return &ServerResponse[T]{
+		StatusCode: status,
+		Header:     nethttp.Header{},
+		Body:       option.Of(body),
+	}
+}
+
+func NewEmptyResponse[T any](status int) *ServerResponse[T] {
+	return &ServerResponse[T]{
+		StatusCode: status,
+		Header:     nethttp.Header{},
+		Body:       option.None[T](),
+	}
+}
+
+func Ok[T any](body T) *ServerResponse[T] {
+	return NewResponse(nethttp.StatusOK, body)
+}
+
+func Created[T any](body T) *ServerResponse[T] {
+	return NewResponse(nethttp.StatusCreated, body)
+}
+
+func NoContent[T any]() *ServerResponse[T] {
+	return NewEmptyResponse[T](nethttp.StatusNoContent)
+}
+
+func (this *ServerResponse[T]) WithHeader(name string, value string) *ServerResponse[T] {
+	this.Header.Set(name, value)
+	return this
+}
+
+// OkIO pure IO of status 200 response
+func OkIO[T any](body T) *rio.IO[*ServerResponse[T]] {
+	return rio.Pure(Ok(body))
+}
+
+// CreatedIO pure IO of status 201 response
+func CreatedIO[T any](body T) *rio.IO[*ServerResponse[T]] {
+	return rio.Pure(Created(body))
+}
+
+// NoContentIO pure IO of status 204 response
+func NoContentIO[T any]() *rio.IO[*ServerResponse[T]] {
+	return rio.Pure(NoContent[T]())
+}
+
+// MapOk map IO value to status 200 response
+func MapOk[T any](io *rio.IO[T]) *rio.IO[*ServerResponse[T]] {
+	return rio.Map(io, Ok[T])
+}
+
+// MapCreated map IO value to status 201 response
+func MapCreated[T any](io *rio.IO[T]) *rio.IO[*ServerResponse[T]] {
+	return rio.Map(io, Created[T])
+}
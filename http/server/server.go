@@ -0,0 +1,222 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	nethttp "net/http"
+
+	"github.com/mobilemindtech/go-io/fault"
+	"github.com/mobilemindtech/go-io/http"
+	"github.com/mobilemindtech/go-io/json"
+	"github.com/mobilemindtech/go-io/logging"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/mobilemindtech/go-io/validation"
+)
+
+// StatusClientClosedRequest non standard status used when client cancel the request
+const StatusClientClosedRequest = 499
+
+// Error is a failure with an explicit http status
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func NewError(status int, message string) *Error {
+	return &Error{StatusCode: status, Message: message}
+}
+
+func BadRequest(message string) *Error {
+	return NewError(nethttp.StatusBadRequest, message)
+}
+
+func NotFound(message string) *Error {
+	return NewError(nethttp.StatusNotFound, message)
+}
+
+func (this *Error) Error() string {
+	return this.Message
+}
+
+// Fail IO failure with an explicit http status
+func Fail[T any](status int, message string) *rio.IO[*ServerResponse[T]] {
+	return rio.Attempt(func() *result.Result[*ServerResponse[T]] {
+		return result.OfError[*ServerResponse[T]](NewError(status, message))
+	})
+}
+
+type ErrorBody struct {
+	Message string            `json:"message"`
+	Errors  map[string]string `json:"errors,omitempty"`
+}
+
+type Handler[T any] func(*Request) *rio.IO[*ServerResponse[T]]
+
+type ErrorHandler func(error) *ServerResponse[any]
+
+// DefaultErrorHandler map errors to responses:
+// - *Error -> Error.StatusCode
+// - *validation.Failure -> 422
+// - context.DeadlineExceeded -> 504
+// - context.Canceled -> 499
+// - *rio.RIOError and others -> 500
+func DefaultErrorHandler(err error) *ServerResponse[any] {
+
+	var serverError *Error
+	var failure *validation.Failure
+	var rioError *rio.RIOError
+
+	switch {
+	case errors.As(err, &serverError):
+		return NewResponse[any](serverError.StatusCode, &ErrorBody{Message: serverError.Message})
+	case errors.As(err, &failure):
+		return NewResponse[any](nethttp.StatusUnprocessableEntity,
+			&ErrorBody{Message: "validation error", Errors: failure.Errors})
+	case errors.Is(err, context.DeadlineExceeded):
+		return NewResponse[any](nethttp.StatusGatewayTimeout, &ErrorBody{Message: err.Error()})
+	case errors.Is(err, context.Canceled):
+		return NewResponse[any](StatusClientClosedRequest, &ErrorBody{Message: err.Error()})
+	case errors.As(err, &rioError):
+		return NewResponse[any](nethttp.StatusInternalServerError, &ErrorBody{Message: rioError.Message})
+	default:
+		return NewResponse[any](nethttp.StatusInternalServerError, &ErrorBody{Message: err.Error()})
+	}
+}
+
+type Router struct {
+	mux          *nethttp.ServeMux
+	errorHandler ErrorHandler
+	encoder      http.HttpEncoder[any]
+	debug        bool
+	logger       *slog.Logger
+}
+
+func NewRouter() *Router {
+	return &Router{
+		mux:          nethttp.NewServeMux(),
+		errorHandler: DefaultErrorHandler,
+		encoder:      json.NewJsonEncoder[any](),
+	}
+}
+
+func (this *Router) Debug() *Router {
+	this.debug = true
+	return this
+}
+
+// WithLogger set router logger. By default the library logger is used
+func (this *Router) WithLogger(logger *slog.Logger) *Router {
+	this.logger = logger
+	return this
+}
+
+func (this *Router) WithErrorHandler(f ErrorHandler) *Router {
+	this.errorHandler = f
+	return this
+}
+
+func (this *Router) WithEncoder(encoder http.HttpEncoder[any]) *Router {
+	this.encoder = encoder
+	return this
+}
+
+func (this *Router) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	this.mux.ServeHTTP(w, r)
+}
+
+// Handle register a typed handler. Pattern follow net/http ServeMux syntax, ex: /users/{id}
+func Handle[T any](router *Router, method http.HttpMethod, pattern string, h Handler[T]) *Router {
+	router.mux.HandleFunc(fmt.Sprintf("%v %v", method, pattern),
+		func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			serve(router, w, r, h)
+		})
+	return router
+}
+
+func Get[T any](router *Router, pattern string, h Handler[T]) *Router {
+	return Handle(router, http.GET, pattern, h)
+}
+
+func Post[T any](router *Router, pattern string, h Handler[T]) *Router {
+	return Handle(router, http.POST, pattern, h)
+}
+
+func Put[T any](router *Router, pattern string, h Handler[T]) *Router {
+	return Handle(router, http.PUT, pattern, h)
+}
+
+func Patch[T any](router *Router, pattern string, h Handler[T]) *Router {
+	return Handle(router, http.PATCH, pattern, h)
+}
+
+func Delete[T any](router *Router, pattern string, h Handler[T]) *Router {
+	return Handle(router, http.DELETE, pattern, h)
+}
+
+func serve[T any](router *Router, w nethttp.ResponseWriter, r *nethttp.Request, h Handler[T]) {
+
+	if router.debug {
+		logging.Or(router.logger).Info("request", "method", r.Method, "url", r.URL.String())
+	}
+
+	res := runHandler(NewRequest(r), h)
+
+	if res.IsError() {
+		writeResponse(router, w, router.errorHandler(res.Failure()))
+		return
+	}
+
+	if res.Get().IsEmpty() {
+		writeResponse(router, w, router.errorHandler(NotFound(nethttp.StatusText(nethttp.StatusNotFound))))
+		return
+	}
+
+	writeResponse(router, w, res.Get().Get())
+}
+
+func runHandler[T any](req *Request, h Handler[T]) (res *result.Result[*option.Option[*ServerResponse[T]]]) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			res = result.OfError[*option.Option[*ServerResponse[T]]](fault.AnyToError(err))
+		}
+	}()
+
+	return rio.UnsafeRun(rio.WithContext(req.Context(), h(req)))
+}
+
+func writeResponse[T any](router *Router, w nethttp.ResponseWriter, resp *ServerResponse[T]) {
+
+	for name, values := range resp.Header {
+		for _, val := range values {
+			w.Header().Add(name, val)
+		}
+	}
+
+	if resp.Body.IsEmpty() {
+		w.WriteHeader(resp.StatusCode)
+		return
+	}
+
+	encoded := router.encoder.Encode(resp.Body.Get())
+
+	if encoded.IsError() {
+		logging.Or(router.logger).Error("response encode error", logging.KeyError, encoded.Failure())
+		w.WriteHeader(nethttp.StatusInternalServerError)
+		return
+	}
+
+	if len(w.Header().Get("Content-Type")) == 0 {
+		w.Header().Set("Content-Type", "application/json")
+	}
+
+	w.WriteHeader(resp.StatusCode)
+
+	if _, err := w.Write(encoded.Get()); err != nil && router.debug {
+		logging.Or(router.logger).Debug("response write error", logging.KeyError, err)
+	}
+}
@@ -0,0 +1,54 @@
+// Command genarity write the arity families of go-io. Run from module root:
+//
+//	go run ./internal/gen/cmd/genarity
+//	go run ./internal/gen/cmd/genarity -family rio
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/mobilemindtech/go-io/internal/gen"
+)
+
+func main() {
+	name := flag.String("family", "", "family to generate, all if empty")
+	flag.Parse()
+
+	families := gen.Families
+	if *name != "" {
+		family, err := gen.Lookup(*name)
+		if err != nil {
+			fail(err)
+		}
+		families = []*gen.Family{family}
+	}
+
+	for _, family := range families {
+		files, err := gen.Generate(family)
+		if err != nil {
+			fail(err)
+		}
+		paths := make([]string, 0, len(files))
+		for path := range files {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				fail(err)
+			}
+			if err := os.WriteFile(path, files[path], 0o644); err != nil {
+				fail(err)
+			}
+		}
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
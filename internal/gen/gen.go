@@ -0,0 +1,160 @@
+// Package gen render the arity families (PipeN, FlatMapN, MapN, ZipN, ...) from
+// templates, so every arity of a family has the same code
+package gen
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"each": each,
+}).ParseFS(templatesFS, "templates/*.tmpl"))
+
+const header = "// Code generated by go-io/internal/gen. DO NOT EDIT.\n\n"
+
+// Param type param of an arity: A, B, C... Index starts at 1 and Pos at 0
+type Param struct {
+	Index int
+	Pos   int
+	Type  string
+}
+
+// Name lower case param name
+func (this Param) Name() string {
+	return strings.ToLower(this.Type)
+}
+
+// Arity type params of a family member
+type Arity struct {
+	N      int
+	Params []Param
+}
+
+func NewArity(n int) *Arity {
+	arity := &Arity{N: n}
+	for i := 0; i < n; i++ {
+		arity.Params = append(arity.Params, Param{Index: i + 1, Pos: i, Type: string(rune('A' + i))})
+	}
+	return arity
+}
+
+// Types type params list: A, B, C
+func (this *Arity) Types() string {
+	return each(this.Params, "{T}")
+}
+
+// Prev arity N-1
+func (this *Arity) Prev() *Arity {
+	return NewArity(this.N - 1)
+}
+
+// Last type param
+func (this *Arity) Last() Param {
+	return this.Params[this.N-1]
+}
+
+// Family functions or effects of many arities rendered by one template. File
+// is the output path, relative to module root. Files with %v are rendered once
+// per arity
+type Family struct {
+	Name     string
+	Doc      string
+	Template string
+	Package  string
+	File     string
+	From, To int
+}
+
+func (this *Family) perArity() bool {
+	return strings.Contains(this.File, "%v")
+}
+
+var Families = []*Family{
+	{Name: "ios.pipe", Template: "ios_pipe.tmpl", Package: "ios", File: "io/ios/io_pipe%v.go", From: 2, To: 10},
+	{Name: "ios.flatmap", Template: "ios_flatmap.tmpl", Package: "ios", File: "io/ios/io_flatmap%v.go", From: 2, To: 10},
+	{Name: "io", Template: "io.tmpl", Package: "io", File: "io/io_arity.go", From: 2, To: 10},
+	{Name: "rio", Template: "rio.tmpl", Package: "rio", File: "rio/rio_arity.go", From: 2, To: 10},
+	{Name: "effect", Template: "effect.tmpl", Package: "effect", File: "effect/effect_arity.go", From: 1, To: 10},
+	{Name: "pipeline", Template: "pipeline.tmpl", Package: "pipeline", File: "pipeline/step_arity.go", From: 1, To: 10},
+	{Name: "validation", Template: "validation.tmpl", Package: "validation", File: "validation/validated_arity.go", From: 2, To: 10},
+	{Name: "tuple", Doc: "Package tuple values of many types, encoded as JSON arrays. TupledN adapt\nfunctions of N args to functions of a tuple", Template: "tuple.tmpl", Package: "tuple", File: "tuple/tuple.go", From: 2, To: 10},
+}
+
+// Lookup family by name
+func Lookup(name string) (*Family, error) {
+	for _, family := range Families {
+		if family.Name == name {
+			return family, nil
+		}
+	}
+	return nil, fmt.Errorf("family %v not found", name)
+}
+
+// Generate family sources by file path
+func Generate(family *Family) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	if family.perArity() {
+		for n := family.From; n <= family.To; n++ {
+			src, err := render(family, []*Arity{NewArity(n)})
+			if err != nil {
+				return nil, err
+			}
+			files[fmt.Sprintf(family.File, n)] = src
+		}
+		return files, nil
+	}
+
+	var arities []*Arity
+	for n := family.From; n <= family.To; n++ {
+		arities = append(arities, NewArity(n))
+	}
+	src, err := render(family, arities)
+	if err != nil {
+		return nil, err
+	}
+	files[family.File] = src
+	return files, nil
+}
+
+func render(family *Family, arities []*Arity) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	if family.Doc != "" {
+		for _, line := range strings.Split(family.Doc, "\n") {
+			fmt.Fprintf(&buf, "// %v\n", line)
+		}
+	}
+	fmt.Fprintf(&buf, "package %v\n", family.Package)
+
+	data := map[string]any{"Arities": arities, "Arity": arities[0]}
+	if err := templates.ExecuteTemplate(&buf, family.Template, data); err != nil {
+		return nil, fmt.Errorf("family %v: %w", family.Name, err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("family %v: %w\n%v", family.Name, err, buf.String())
+	}
+	return src, nil
+}
+
+// each format params and join with comma. {T} is the param type, {t} the
+// param name and {i} the param index
+func each(params []Param, format string) string {
+	items := make([]string, len(params))
+	for i, param := range params {
+		items[i] = strings.NewReplacer(
+			"{T}", param.Type,
+			"{t}", param.Name(),
+			"{i}", fmt.Sprint(param.Index),
+		).Replace(format)
+	}
+	return strings.Join(items, ", ")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
+	"github.com/mobilemindtech/go-io/validation"
 )
 
 func IOUnit(effs ...types.IOEffect) *types.IO[*unit.Unit] {
@@ -67,22 +68,6 @@ func FlatMap1[A, B any](ioA *types.IO[A], f func(A) *types.IO[B]) *ios.IOFlatMap
 	return ios.NewFlatMapIO[A, B](ioA, f)
 }
 
-func FlatMap2[A, B, T any](ioA *types.IO[A], ioB *types.IO[B], f func(A, B) *types.IO[T]) *ios.IOFlatMap2[A, B, T] {
-	return ios.NewFlatMap2[A, B, T](ioA, ioB, f)
-}
-
-func FlatMap3[A, B, C, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], f func(A, B, C) *types.IO[T]) *ios.IOFlatMap3[A, B, C, T] {
-	return ios.NewFlatMap3[A, B, C, T](ioA, ioB, ioC, f)
-}
-
-func FlatMap4[A, B, C, D, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], f func(A, B, C, D) *types.IO[T]) *ios.IOFlatMap4[A, B, C, D, T] {
-	return ios.NewFlatMap4[A, B, C, D, T](ioA, ioB, ioC, ioD, f)
-}
-
-func FlatMap5[A, B, C, D, E, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], f func(A, B, C, D, E) *types.IO[T]) *ios.IOFlatMap5[A, B, C, D, E, T] {
-	return ios.NewFlatMap5[A, B, C, D, E, T](ioA, ioB, ioC, ioD, ioE, f)
-}
-
 func Map[A, B any](f func(A) B) *ios.IOMap[A, B] {
 	return ios.NewMap[A, B](f)
 }
@@ -197,6 +182,11 @@ func FailIf[A any](f func(A) error) *ios.IOFailIf[A] {
 	return ios.NewFailIf[A](f)
 }
 
+// Validate fail with *validation.Failure when validator return invalid
+func Validate[A any](validator func(A) *validation.V[A]) *ios.IOValidate[A] {
+	return ios.NewValidate[A](validator)
+}
+
 func OrElse[A any](f func() *types.IO[A]) *ios.IOOrElse[A] {
 	return ios.NewOrElse[A](f)
 }
@@ -381,186 +371,6 @@ func PipeOfOption[A, T any](f func(A) *option.Option[T]) *ios.IOPipe[A, T] {
 	return ios.NewPipeOfOption[A, T](f)
 }
 
-func Pipe2IO[A, B, T any](f func(A, B) *types.IO[T]) *ios.IOPipe2[A, B, T] {
-	return ios.NewPipe2IO[A, B, T](f)
-}
-
-func Pipe2[A, B, T any](f func(A, B) *result.Result[*option.Option[T]]) *ios.IOPipe2[A, B, T] {
-	return ios.NewPipe2[A, B, T](f)
-}
-
-func Pipe2OfValue[A, B, T any](f func(A, B) T) *ios.IOPipe2[A, B, T] {
-	return ios.NewPipe2OfValue[A, B, T](f)
-}
-
-func Pipe2OfResult[A, B, T any](f func(A, B) *result.Result[T]) *ios.IOPipe2[A, B, T] {
-	return ios.NewPipe2OfResult[A, B, T](f)
-}
-
-func Pipe2OfOption[A, B, T any](f func(A, B) *option.Option[T]) *ios.IOPipe2[A, B, T] {
-	return ios.NewPipe2OfOption[A, B, T](f)
-}
-
-func Pipe3IO[A, B, C, T any](f func(A, B, C) *types.IO[T]) *ios.IOPipe3[A, B, C, T] {
-	return ios.NewPipe3IO[A, B, C, T](f)
-}
-
-func Pipe3[A, B, C, T any](f func(A, B, C) *result.Result[*option.Option[T]]) *ios.IOPipe3[A, B, C, T] {
-	return ios.NewPipe3[A, B, C, T](f)
-}
-
-func Pipe3OfValue[A, B, C, T any](f func(A, B, C) T) *ios.IOPipe3[A, B, C, T] {
-	return ios.NewPipe3OfValue[A, B, C, T](f)
-}
-
-func Pipe3OfResult[A, B, C, T any](f func(A, B, C) *result.Result[T]) *ios.IOPipe3[A, B, C, T] {
-	return ios.NewPipe3OfResult[A, B, C, T](f)
-}
-
-func Pipe3OfOption[A, B, C, T any](f func(A, B, C) *option.Option[T]) *ios.IOPipe3[A, B, C, T] {
-	return ios.NewPipe3OfOption[A, B, C, T](f)
-}
-
-func Pipe4IO[A, B, C, D, T any](f func(A, B, C, D) *types.IO[T]) *ios.IOPipe4[A, B, C, D, T] {
-	return ios.NewPipe4IO[A, B, C, D, T](f)
-}
-
-func Pipe4[A, B, C, D, T any](f func(A, B, C, D) *result.Result[*option.Option[T]]) *ios.IOPipe4[A, B, C, D, T] {
-	return ios.NewPipe4[A, B, C, D, T](f)
-}
-
-func Pipe4OfValue[A, B, C, D, T any](f func(A, B, C, D) T) *ios.IOPipe4[A, B, C, D, T] {
-	return ios.NewPipe4OfValue[A, B, C, D, T](f)
-}
-
-func Pipe4OfResult[A, B, C, D, T any](f func(A, B, C, D) *result.Result[T]) *ios.IOPipe4[A, B, C, D, T] {
-	return ios.NewPipe4OfResult[A, B, C, D, T](f)
-}
-
-func Pipe4OfOption[A, B, C, D, T any](f func(A, B, C, D) *option.Option[T]) *ios.IOPipe4[A, B, C, D, T] {
-	return ios.NewPipe4OfOption[A, B, C, D, T](f)
-}
-
-func Pipe5IO[A, B, C, D, E, T any](f func(A, B, C, D, E) *types.IO[T]) *ios.IOPipe5[A, B, C, D, E, T] {
-	return ios.NewPipe5IO[A, B, C, D, E, T](f)
-}
-
-func Pipe5[A, B, C, D, E, T any](f func(A, B, C, D, E) *result.Result[*option.Option[T]]) *ios.IOPipe5[A, B, C, D, E, T] {
-	return ios.NewPipe5[A, B, C, D, E, T](f)
-}
-
-func Pipe5OfValue[A, B, C, D, E, T any](f func(A, B, C, D, E) T) *ios.IOPipe5[A, B, C, D, E, T] {
-	return ios.NewPipe5OfValue[A, B, C, D, E, T](f)
-}
-
-func Pipe5OfResult[A, B, C, D, E, T any](f func(A, B, C, D, E) *result.Result[T]) *ios.IOPipe5[A, B, C, D, E, T] {
-	return ios.NewPipe5OfResult[A, B, C, D, E, T](f)
-}
-
-func Pipe5OfOption[A, B, C, D, E, T any](f func(A, B, C, D, E) *option.Option[T]) *ios.IOPipe5[A, B, C, D, E, T] {
-	return ios.NewPipe5OfOption[A, B, C, D, E, T](f)
-}
-
-func Pipe6IO[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) *types.IO[T]) *ios.IOPipe6[A, B, C, D, E, F, T] {
-	return ios.NewPipe6IO[A, B, C, D, E, F, T](f)
-}
-
-func Pipe6[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) *result.Result[*option.Option[T]]) *ios.IOPipe6[A, B, C, D, E, F, T] {
-	return ios.NewPipe6[A, B, C, D, E, F, T](f)
-}
-
-func Pipe6OfValue[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) T) *ios.IOPipe6[A, B, C, D, E, F, T] {
-	return ios.NewPipe6OfValue[A, B, C, D, E, F, T](f)
-}
-
-func Pipe6OfResult[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) *result.Result[T]) *ios.IOPipe6[A, B, C, D, E, F, T] {
-	return ios.NewPipe6OfResult[A, B, C, D, E, F, T](f)
-}
-
-func Pipe6OfOption[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) *option.Option[T]) *ios.IOPipe6[A, B, C, D, E, F, T] {
-	return ios.NewPipe6OfOption[A, B, C, D, E, F, T](f)
-}
-
-func Pipe7IO[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) *types.IO[T]) *ios.IOPipe7[A, B, C, D, E, F, G, T] {
-	return ios.NewPipe7IO[A, B, C, D, E, F, G, T](f)
-}
-
-func Pipe7[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) *result.Result[*option.Option[T]]) *ios.IOPipe7[A, B, C, D, E, F, G, T] {
-	return ios.NewPipe7[A, B, C, D, E, F, G, T](f)
-}
-
-func Pipe7OfValue[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) T) *ios.IOPipe7[A, B, C, D, E, F, G, T] {
-	return ios.NewPipe7OfValue[A, B, C, D, E, F, G, T](f)
-}
-
-func Pipe7OfResult[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) *result.Result[T]) *ios.IOPipe7[A, B, C, D, E, F, G, T] {
-	return ios.NewPipe7OfResult[A, B, C, D, E, F, G, T](f)
-}
-
-func Pipe7OfOption[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) *option.Option[T]) *ios.IOPipe7[A, B, C, D, E, F, G, T] {
-	return ios.NewPipe7OfOption[A, B, C, D, E, F, G, T](f)
-}
-
-func Pipe8IO[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) *types.IO[T]) *ios.IOPipe8[A, B, C, D, E, F, G, H, T] {
-	return ios.NewPipe8IO[A, B, C, D, E, F, G, H, T](f)
-}
-
-func Pipe8[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) *result.Result[*option.Option[T]]) *ios.IOPipe8[A, B, C, D, E, F, G, H, T] {
-	return ios.NewPipe8[A, B, C, D, E, F, G, H, T](f)
-}
-
-func Pipe8OfValue[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) T) *ios.IOPipe8[A, B, C, D, E, F, G, H, T] {
-	return ios.NewPipe8OfValue[A, B, C, D, E, F, G, H, T](f)
-}
-
-func Pipe8OfResult[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) *result.Result[T]) *ios.IOPipe8[A, B, C, D, E, F, G, H, T] {
-	return ios.NewPipe8OfResult[A, B, C, D, E, F, G, H, T](f)
-}
-
-func Pipe8OfOption[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) *option.Option[T]) *ios.IOPipe8[A, B, C, D, E, F, G, H, T] {
-	return ios.NewPipe8OfOption[A, B, C, D, E, F, G, H, T](f)
-}
-
-func Pipe9IO[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) *types.IO[T]) *ios.IOPipe9[A, B, C, D, E, F, G, H, I, T] {
-	return ios.NewPipe9IO[A, B, C, D, E, F, G, H, I, T](f)
-}
-
-func Pipe9[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) *result.Result[*option.Option[T]]) *ios.IOPipe9[A, B, C, D, E, F, G, H, I, T] {
-	return ios.NewPipe9[A, B, C, D, E, F, G, H, I, T](f)
-}
-
-func Pipe9OfValue[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) T) *ios.IOPipe9[A, B, C, D, E, F, G, H, I, T] {
-	return ios.NewPipe9OfValue[A, B, C, D, E, F, G, H, I, T](f)
-}
-
-func Pipe9OfResult[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) *result.Result[T]) *ios.IOPipe9[A, B, C, D, E, F, G, H, I, T] {
-	return ios.NewPipe9OfResult[A, B, C, D, E, F, G, H, I, T](f)
-}
-
-func Pipe9OfOption[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) *option.Option[T]) *ios.IOPipe9[A, B, C, D, E, F, G, H, I, T] {
-	return ios.NewPipe9OfOption[A, B, C, D, E, F, G, H, I, T](f)
-}
-
-func Pipe10IO[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) *types.IO[T]) *ios.IOPipe10[A, B, C, D, E, F, G, H, I, J, T] {
-	return ios.NewPipe10IO[A, B, C, D, E, F, G, H, I, J, T](f)
-}
-
-func Pipe10[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) *result.Result[*option.Option[T]]) *ios.IOPipe10[A, B, C, D, E, F, G, H, I, J, T] {
-	return ios.NewPipe10[A, B, C, D, E, F, G, H, I, J, T](f)
-}
-
-func Pipe10OfValue[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) T) *ios.IOPipe10[A, B, C, D, E, F, G, H, I, J, T] {
-	return ios.NewPipe10OfValue[A, B, C, D, E, F, G, H, I, J, T](f)
-}
-
-func Pipe10OfResult[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) *result.Result[T]) *ios.IOPipe10[A, B, C, D, E, F, G, H, I, J, T] {
-	return ios.NewPipe10OfResult[A, B, C, D, E, F, G, H, I, J, T](f)
-}
-
-func Pipe10OfOption[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) *option.Option[T]) *ios.IOPipe10[A, B, C, D, E, F, G, H, I, J, T] {
-	return ios.NewPipe10OfOption[A, B, C, D, E, F, G, H, I, J, T](f)
-}
-
 func IOApp[T any](effects ...types.IORunnable) *runtime.IOApp[T] {
 	return runtime.New[T](effects...)
 }
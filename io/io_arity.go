@@ -0,0 +1,290 @@
+// Code generated by go-io/internal/gen. DO NOT EDIT.
+
+package io
+
+import (
+	"github.com/mobilemindtech/go-io/io/ios"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/tuple"
+	"github.com/mobilemindtech/go-io/types"
+)
+
+func FlatMap2[A, B, T any](ioA *types.IO[A], ioB *types.IO[B], f func(A, B) *types.IO[T]) *ios.IOFlatMap2[A, B, T] {
+	return ios.NewFlatMap2[A, B, T](ioA, ioB, f)
+}
+
+func FlatMap3[A, B, C, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], f func(A, B, C) *types.IO[T]) *ios.IOFlatMap3[A, B, C, T] {
+	return ios.NewFlatMap3[A, B, C, T](ioA, ioB, ioC, f)
+}
+
+func FlatMap4[A, B, C, D, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], f func(A, B, C, D) *types.IO[T]) *ios.IOFlatMap4[A, B, C, D, T] {
+	return ios.NewFlatMap4[A, B, C, D, T](ioA, ioB, ioC, ioD, f)
+}
+
+func FlatMap5[A, B, C, D, E, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], f func(A, B, C, D, E) *types.IO[T]) *ios.IOFlatMap5[A, B, C, D, E, T] {
+	return ios.NewFlatMap5[A, B, C, D, E, T](ioA, ioB, ioC, ioD, ioE, f)
+}
+
+func FlatMap6[A, B, C, D, E, F, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], f func(A, B, C, D, E, F) *types.IO[T]) *ios.IOFlatMap6[A, B, C, D, E, F, T] {
+	return ios.NewFlatMap6[A, B, C, D, E, F, T](ioA, ioB, ioC, ioD, ioE, ioF, f)
+}
+
+func FlatMap7[A, B, C, D, E, F, G, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G], f func(A, B, C, D, E, F, G) *types.IO[T]) *ios.IOFlatMap7[A, B, C, D, E, F, G, T] {
+	return ios.NewFlatMap7[A, B, C, D, E, F, G, T](ioA, ioB, ioC, ioD, ioE, ioF, ioG, f)
+}
+
+func FlatMap8[A, B, C, D, E, F, G, H, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G], ioH *types.IO[H], f func(A, B, C, D, E, F, G, H) *types.IO[T]) *ios.IOFlatMap8[A, B, C, D, E, F, G, H, T] {
+	return ios.NewFlatMap8[A, B, C, D, E, F, G, H, T](ioA, ioB, ioC, ioD, ioE, ioF, ioG, ioH, f)
+}
+
+func FlatMap9[A, B, C, D, E, F, G, H, I, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G], ioH *types.IO[H], ioI *types.IO[I], f func(A, B, C, D, E, F, G, H, I) *types.IO[T]) *ios.IOFlatMap9[A, B, C, D, E, F, G, H, I, T] {
+	return ios.NewFlatMap9[A, B, C, D, E, F, G, H, I, T](ioA, ioB, ioC, ioD, ioE, ioF, ioG, ioH, ioI, f)
+}
+
+func FlatMap10[A, B, C, D, E, F, G, H, I, J, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G], ioH *types.IO[H], ioI *types.IO[I], ioJ *types.IO[J], f func(A, B, C, D, E, F, G, H, I, J) *types.IO[T]) *ios.IOFlatMap10[A, B, C, D, E, F, G, H, I, J, T] {
+	return ios.NewFlatMap10[A, B, C, D, E, F, G, H, I, J, T](ioA, ioB, ioC, ioD, ioE, ioF, ioG, ioH, ioI, ioJ, f)
+}
+
+// Zip2 values of IOs as a tuple
+func Zip2[A, B any](ioA *types.IO[A], ioB *types.IO[B]) *ios.IOFlatMap2[A, B, *tuple.T2[A, B]] {
+	return ios.NewFlatMap2(ioA, ioB, func(a A, b B) *types.IO[*tuple.T2[A, B]] {
+		return ios.NewPureValue(tuple.Of2(a, b)).Lift()
+	})
+}
+
+// Zip3 values of IOs as a tuple
+func Zip3[A, B, C any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C]) *ios.IOFlatMap3[A, B, C, *tuple.T3[A, B, C]] {
+	return ios.NewFlatMap3(ioA, ioB, ioC, func(a A, b B, c C) *types.IO[*tuple.T3[A, B, C]] {
+		return ios.NewPureValue(tuple.Of3(a, b, c)).Lift()
+	})
+}
+
+// Zip4 values of IOs as a tuple
+func Zip4[A, B, C, D any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D]) *ios.IOFlatMap4[A, B, C, D, *tuple.T4[A, B, C, D]] {
+	return ios.NewFlatMap4(ioA, ioB, ioC, ioD, func(a A, b B, c C, d D) *types.IO[*tuple.T4[A, B, C, D]] {
+		return ios.NewPureValue(tuple.Of4(a, b, c, d)).Lift()
+	})
+}
+
+// Zip5 values of IOs as a tuple
+func Zip5[A, B, C, D, E any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E]) *ios.IOFlatMap5[A, B, C, D, E, *tuple.T5[A, B, C, D, E]] {
+	return ios.NewFlatMap5(ioA, ioB, ioC, ioD, ioE, func(a A, b B, c C, d D, e E) *types.IO[*tuple.T5[A, B, C, D, E]] {
+		return ios.NewPureValue(tuple.Of5(a, b, c, d, e)).Lift()
+	})
+}
+
+// Zip6 values of IOs as a tuple
+func Zip6[A, B, C, D, E, F any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F]) *ios.IOFlatMap6[A, B, C, D, E, F, *tuple.T6[A, B, C, D, E, F]] {
+	return ios.NewFlatMap6(ioA, ioB, ioC, ioD, ioE, ioF, func(a A, b B, c C, d D, e E, f F) *types.IO[*tuple.T6[A, B, C, D, E, F]] {
+		return ios.NewPureValue(tuple.Of6(a, b, c, d, e, f)).Lift()
+	})
+}
+
+// Zip7 values of IOs as a tuple
+func Zip7[A, B, C, D, E, F, G any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G]) *ios.IOFlatMap7[A, B, C, D, E, F, G, *tuple.T7[A, B, C, D, E, F, G]] {
+	return ios.NewFlatMap7(ioA, ioB, ioC, ioD, ioE, ioF, ioG, func(a A, b B, c C, d D, e E, f F, g G) *types.IO[*tuple.T7[A, B, C, D, E, F, G]] {
+		return ios.NewPureValue(tuple.Of7(a, b, c, d, e, f, g)).Lift()
+	})
+}
+
+// Zip8 values of IOs as a tuple
+func Zip8[A, B, C, D, E, F, G, H any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G], ioH *types.IO[H]) *ios.IOFlatMap8[A, B, C, D, E, F, G, H, *tuple.T8[A, B, C, D, E, F, G, H]] {
+	return ios.NewFlatMap8(ioA, ioB, ioC, ioD, ioE, ioF, ioG, ioH, func(a A, b B, c C, d D, e E, f F, g G, h H) *types.IO[*tuple.T8[A, B, C, D, E, F, G, H]] {
+		return ios.NewPureValue(tuple.Of8(a, b, c, d, e, f, g, h)).Lift()
+	})
+}
+
+// Zip9 values of IOs as a tuple
+func Zip9[A, B, C, D, E, F, G, H, I any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G], ioH *types.IO[H], ioI *types.IO[I]) *ios.IOFlatMap9[A, B, C, D, E, F, G, H, I, *tuple.T9[A, B, C, D, E, F, G, H, I]] {
+	return ios.NewFlatMap9(ioA, ioB, ioC, ioD, ioE, ioF, ioG, ioH, ioI, func(a A, b B, c C, d D, e E, f F, g G, h H, i I) *types.IO[*tuple.T9[A, B, C, D, E, F, G, H, I]] {
+		return ios.NewPureValue(tuple.Of9(a, b, c, d, e, f, g, h, i)).Lift()
+	})
+}
+
+// Zip10 values of IOs as a tuple
+func Zip10[A, B, C, D, E, F, G, H, I, J any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G], ioH *types.IO[H], ioI *types.IO[I], ioJ *types.IO[J]) *ios.IOFlatMap10[A, B, C, D, E, F, G, H, I, J, *tuple.T10[A, B, C, D, E, F, G, H, I, J]] {
+	return ios.NewFlatMap10(ioA, ioB, ioC, ioD, ioE, ioF, ioG, ioH, ioI, ioJ, func(a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) *types.IO[*tuple.T10[A, B, C, D, E, F, G, H, I, J]] {
+		return ios.NewPureValue(tuple.Of10(a, b, c, d, e, f, g, h, i, j)).Lift()
+	})
+}
+
+func Pipe2IO[A, B, T any](f func(A, B) *types.IO[T]) *ios.IOPipe2[A, B, T] {
+	return ios.NewPipe2IO[A, B, T](f)
+}
+
+func Pipe2[A, B, T any](f func(A, B) *result.Result[*option.Option[T]]) *ios.IOPipe2[A, B, T] {
+	return ios.NewPipe2[A, B, T](f)
+}
+
+func Pipe2OfValue[A, B, T any](f func(A, B) T) *ios.IOPipe2[A, B, T] {
+	return ios.NewPipe2OfValue[A, B, T](f)
+}
+
+func Pipe2OfResult[A, B, T any](f func(A, B) *result.Result[T]) *ios.IOPipe2[A, B, T] {
+	return ios.NewPipe2OfResult[A, B, T](f)
+}
+
+func Pipe2OfOption[A, B, T any](f func(A, B) *option.Option[T]) *ios.IOPipe2[A, B, T] {
+	return ios.NewPipe2OfOption[A, B, T](f)
+}
+
+func Pipe3IO[A, B, C, T any](f func(A, B, C) *types.IO[T]) *ios.IOPipe3[A, B, C, T] {
+	return ios.NewPipe3IO[A, B, C, T](f)
+}
+
+func Pipe3[A, B, C, T any](f func(A, B, C) *result.Result[*option.Option[T]]) *ios.IOPipe3[A, B, C, T] {
+	return ios.NewPipe3[A, B, C, T](f)
+}
+
+func Pipe3OfValue[A, B, C, T any](f func(A, B, C) T) *ios.IOPipe3[A, B, C, T] {
+	return ios.NewPipe3OfValue[A, B, C, T](f)
+}
+
+func Pipe3OfResult[A, B, C, T any](f func(A, B, C) *result.Result[T]) *ios.IOPipe3[A, B, C, T] {
+	return ios.NewPipe3OfResult[A, B, C, T](f)
+}
+
+func Pipe3OfOption[A, B, C, T any](f func(A, B, C) *option.Option[T]) *ios.IOPipe3[A, B, C, T] {
+	return ios.NewPipe3OfOption[A, B, C, T](f)
+}
+
+func Pipe4IO[A, B, C, D, T any](f func(A, B, C, D) *types.IO[T]) *ios.IOPipe4[A, B, C, D, T] {
+	return ios.NewPipe4IO[A, B, C, D, T](f)
+}
+
+func Pipe4[A, B, C, D, T any](f func(A, B, C, D) *result.Result[*option.Option[T]]) *ios.IOPipe4[A, B, C, D, T] {
+	return ios.NewPipe4[A, B, C, D, T](f)
+}
+
+func Pipe4OfValue[A, B, C, D, T any](f func(A, B, C, D) T) *ios.IOPipe4[A, B, C, D, T] {
+	return ios.NewPipe4OfValue[A, B, C, D, T](f)
+}
+
+func Pipe4OfResult[A, B, C, D, T any](f func(A, B, C, D) *result.Result[T]) *ios.IOPipe4[A, B, C, D, T] {
+	return ios.NewPipe4OfResult[A, B, C, D, T](f)
+}
+
+func Pipe4OfOption[A, B, C, D, T any](f func(A, B, C, D) *option.Option[T]) *ios.IOPipe4[A, B, C, D, T] {
+	return ios.NewPipe4OfOption[A, B, C, D, T](f)
+}
+
+func Pipe5IO[A, B, C, D, E, T any](f func(A, B, C, D, E) *types.IO[T]) *ios.IOPipe5[A, B, C, D, E, T] {
+	return ios.NewPipe5IO[A, B, C, D, E, T](f)
+}
+
+func Pipe5[A, B, C, D, E, T any](f func(A, B, C, D, E) *result.Result[*option.Option[T]]) *ios.IOPipe5[A, B, C, D, E, T] {
+	return ios.NewPipe5[A, B, C, D, E, T](f)
+}
+
+func Pipe5OfValue[A, B, C, D, E, T any](f func(A, B, C, D, E) T) *ios.IOPipe5[A, B, C, D, E, T] {
+	return ios.NewPipe5OfValue[A, B, C, D, E, T](f)
+}
+
+func Pipe5OfResult[A, B, C, D, E, T any](f func(A, B, C, D, E) *result.Result[T]) *ios.IOPipe5[A, B, C, D, E, T] {
+	return ios.NewPipe5OfResult[A, B, C, D, E, T](f)
+}
+
+func Pipe5OfOption[A, B, C, D, E, T any](f func(A, B, C, D, E) *option.Option[T]) *ios.IOPipe5[A, B, C, D, E, T] {
+	return ios.NewPipe5OfOption[A, B, C, D, E, T](f)
+}
+
+func Pipe6IO[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) *types.IO[T]) *ios.IOPipe6[A, B, C, D, E, F, T] {
+	return ios.NewPipe6IO[A, B, C, D, E, F, T](f)
+}
+
+func Pipe6[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) *result.Result[*option.Option[T]]) *ios.IOPipe6[A, B, C, D, E, F, T] {
+	return ios.NewPipe6[A, B, C, D, E, F, T](f)
+}
+
+func Pipe6OfValue[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) T) *ios.IOPipe6[A, B, C, D, E, F, T] {
+	return ios.NewPipe6OfValue[A, B, C, D, E, F, T](f)
+}
+
+func Pipe6OfResult[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) *result.Result[T]) *ios.IOPipe6[A, B, C, D, E, F, T] {
+	return ios.NewPipe6OfResult[A, B, C, D, E, F, T](f)
+}
+
+func Pipe6OfOption[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) *option.Option[T]) *ios.IOPipe6[A, B, C, D, E, F, T] {
+	return ios.NewPipe6OfOption[A, B, C, D, E, F, T](f)
+}
+
+func Pipe7IO[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) *types.IO[T]) *ios.IOPipe7[A, B, C, D, E, F, G, T] {
+	return ios.NewPipe7IO[A, B, C, D, E, F, G, T](f)
+}
+
+func Pipe7[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) *result.Result[*option.Option[T]]) *ios.IOPipe7[A, B, C, D, E, F, G, T] {
+	return ios.NewPipe7[A, B, C, D, E, F, G, T](f)
+}
+
+func Pipe7OfValue[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) T) *ios.IOPipe7[A, B, C, D, E, F, G, T] {
+	return ios.NewPipe7OfValue[A, B, C, D, E, F, G, T](f)
+}
+
+func Pipe7OfResult[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) *result.Result[T]) *ios.IOPipe7[A, B, C, D, E, F, G, T] {
+	return ios.NewPipe7OfResult[A, B, C, D, E, F, G, T](f)
+}
+
+func Pipe7OfOption[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) *option.Option[T]) *ios.IOPipe7[A, B, C, D, E, F, G, T] {
+	return ios.NewPipe7OfOption[A, B, C, D, E, F, G, T](f)
+}
+
+func Pipe8IO[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) *types.IO[T]) *ios.IOPipe8[A, B, C, D, E, F, G, H, T] {
+	return ios.NewPipe8IO[A, B, C, D, E, F, G, H, T](f)
+}
+
+func Pipe8[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) *result.Result[*option.Option[T]]) *ios.IOPipe8[A, B, C, D, E, F, G, H, T] {
+	return ios.NewPipe8[A, B, C, D, E, F, G, H, T](f)
+}
+
+func Pipe8OfValue[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) T) *ios.IOPipe8[A, B, C, D, E, F, G, H, T] {
+	return ios.NewPipe8OfValue[A, B, C, D, E, F, G, H, T](f)
+}
+
+func Pipe8OfResult[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) *result.Result[T]) *ios.IOPipe8[A, B, C, D, E, F, G, H, T] {
+	return ios.NewPipe8OfResult[A, B, C, D, E, F, G, H, T](f)
+}
+
+func Pipe8OfOption[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) *option.Option[T]) *ios.IOPipe8[A, B, C, D, E, F, G, H, T] {
+	return ios.NewPipe8OfOption[A, B, C, D, E, F, G, H, T](f)
+}
+
+func Pipe9IO[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) *types.IO[T]) *ios.IOPipe9[A, B, C, D, E, F, G, H, I, T] {
+	return ios.NewPipe9IO[A, B, C, D, E, F, G, H, I, T](f)
+}
+
+func Pipe9[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) *result.Result[*option.Option[T]]) *ios.IOPipe9[A, B, C, D, E, F, G, H, I, T] {
+	return ios.NewPipe9[A, B, C, D, E, F, G, H, I, T](f)
+}
+
+func Pipe9OfValue[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) T) *ios.IOPipe9[A, B, C, D, E, F, G, H, I, T] {
+	return ios.NewPipe9OfValue[A, B, C, D, E, F, G, H, I, T](f)
+}
+
+func Pipe9OfResult[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) *result.Result[T]) *ios.IOPipe9[A, B, C, D, E, F, G, H, I, T] {
+	return ios.NewPipe9OfResult[A, B, C, D, E, F, G, H, I, T](f)
+}
+
+func Pipe9OfOption[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) *option.Option[T]) *ios.IOPipe9[A, B, C, D, E, F, G, H, I, T] {
+	return ios.NewPipe9OfOption[A, B, C, D, E, F, G, H, I, T](f)
+}
+
+func Pipe10IO[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) *types.IO[T]) *ios.IOPipe10[A, B, C, D, E, F, G, H, I, J, T] {
+	return ios.NewPipe10IO[A, B, C, D, E, F, G, H, I, J, T](f)
+}
+
+func Pipe10[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) *result.Result[*option.Option[T]]) *ios.IOPipe10[A, B, C, D, E, F, G, H, I, J, T] {
+	return ios.NewPipe10[A, B, C, D, E, F, G, H, I, J, T](f)
+}
+
+func Pipe10OfValue[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) T) *ios.IOPipe10[A, B, C, D, E, F, G, H, I, J, T] {
+	return ios.NewPipe10OfValue[A, B, C, D, E, F, G, H, I, J, T](f)
+}
+
+func Pipe10OfResult[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) *result.Result[T]) *ios.IOPipe10[A, B, C, D, E, F, G, H, I, J, T] {
+	return ios.NewPipe10OfResult[A, B, C, D, E, F, G, H, I, J, T](f)
+}
+
+func Pipe10OfOption[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) *option.Option[T]) *ios.IOPipe10[A, B, C, D, E, F, G, H, I, J, T] {
+	return ios.NewPipe10OfOption[A, B, C, D, E, F, G, H, I, J, T](f)
+}
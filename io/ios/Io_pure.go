@@ -6,7 +6,6 @@ import (
 	"github.com/mobilemindtech/go-io/result"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
-	"log"
 	"reflect"
 )
 
@@ -78,9 +77,5 @@ func (this *IOPure[T]) UnsafeRun() types.IOEffect {
 		this.value = option.Of(this.f())
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return eff.(types.IOEffect)
 }
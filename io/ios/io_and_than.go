@@ -8,7 +8,6 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
-	"log"
 	"reflect"
 )
 
@@ -99,10 +98,6 @@ func (this *IOAndThan[A]) UnsafeRun() types.IOEffect {
 				WithDebug(this.debug).
 				UnsafeRun()
 		}
-	} 
-
-	if this.debug {
-		log.Printf("%v\n", this.String())
 	}
 
 	return currEff.(types.IOEffect)
@@ -7,7 +7,7 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
-	"log"
+	"log/slog"
 	"reflect"
 )
 
@@ -32,6 +32,7 @@ type IOAttempt[A any] struct {
 	state     *state.State
 	debug     bool
 	debugInfo *types.IODebugInfo
+	logger    *slog.Logger
 }
 
 func NewAttempt[A any](f func() *result.Result[A]) *IOAttempt[A] {
@@ -94,6 +95,14 @@ func (this *IOAttempt[T]) GetDebugInfo() *types.IODebugInfo {
 	return this.debugInfo
 }
 
+func (this *IOAttempt[T]) SetLogger(logger *slog.Logger) {
+	this.logger = logger
+}
+
+func (this *IOAttempt[T]) GetLogger() *slog.Logger {
+	return this.logger
+}
+
 func (this *IOAttempt[T]) TypeIn() reflect.Type {
 	return reflect.TypeFor[*unit.Unit]()
 }
@@ -189,10 +198,6 @@ func (this *IOAttempt[A]) UnsafeRun() types.IOEffect {
 		}
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
 
@@ -8,7 +8,7 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
-	"log"
+	"log/slog"
 	"reflect"
 )
 
@@ -22,6 +22,7 @@ type IOAttemptAndThan[A any] struct {
 	state     *state.State
 	debug     bool
 	debugInfo *types.IODebugInfo
+	logger    *slog.Logger
 }
 
 func NewAttemptAndThanWithState[A any](f func(*state.State) *types.IO[A]) *IOAttemptAndThan[A] {
@@ -56,6 +57,14 @@ func (this *IOAttemptAndThan[A]) GetDebugInfo() *types.IODebugInfo {
 	return this.debugInfo
 }
 
+func (this *IOAttemptAndThan[A]) SetLogger(logger *slog.Logger) {
+	this.logger = logger
+}
+
+func (this *IOAttemptAndThan[A]) GetLogger() *slog.Logger {
+	return this.logger
+}
+
 func (this *IOAttemptAndThan[A]) TypeIn() reflect.Type {
 	return reflect.TypeFor[*unit.Unit]()
 }
@@ -124,10 +133,6 @@ func (this *IOAttemptAndThan[A]) UnsafeRun() types.IOEffect {
 		this.value = runtime.NewWithState[A](this.state, runnableIO).UnsafeRun()
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
 
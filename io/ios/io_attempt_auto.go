@@ -9,7 +9,7 @@ import (
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
+	"log/slog"
 	"reflect"
 )
 
@@ -22,6 +22,7 @@ type IOAttemptAuto[A any] struct {
 	state     *state.State
 	debug     bool
 	debugInfo *types.IODebugInfo
+	logger    *slog.Logger
 }
 
 // NewAttemptAuto f should be a func that return:
@@ -52,6 +53,14 @@ func (this *IOAttemptAuto[A]) GetDebugInfo() *types.IODebugInfo {
 	return this.debugInfo
 }
 
+func (this *IOAttemptAuto[A]) SetLogger(logger *slog.Logger) {
+	this.logger = logger
+}
+
+func (this *IOAttemptAuto[A]) GetLogger() *slog.Logger {
+	return this.logger
+}
+
 func (this *IOAttemptAuto[A]) TypeIn() reflect.Type {
 	return reflect.TypeFor[*unit.Unit]()
 }
@@ -68,6 +77,17 @@ func (this *IOAttemptAuto[A]) SetState(st *state.State) {
 	this.state = st
 }
 
+func (this *IOAttemptAuto[A]) StateReads() []reflect.Type {
+	var reads []reflect.Type
+	if this.fnAuto != nil {
+		info := util.NewFuncInfo(this.fnAuto)
+		for i := 0; i < info.ArgsCount; i++ {
+			reads = append(reads, info.ArgType(i))
+		}
+	}
+	return reads
+}
+
 func (this *IOAttemptAuto[A]) SetPrevEffect(prev types.IOEffect) {
 	this.prevEffect = prev
 }
@@ -220,10 +240,6 @@ func (this *IOAttemptAuto[A]) UnsafeRun() types.IOEffect {
 
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
 
@@ -7,7 +7,7 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
+	"log/slog"
 	"reflect"
 )
 
@@ -21,6 +21,7 @@ type IOAttemptExec[A any] struct {
 	state     *state.State
 	debug     bool
 	debugInfo *types.IODebugInfo
+	logger    *slog.Logger
 }
 
 func NewAttemptExec[A any](f func(A)) *IOAttemptExec[A] {
@@ -47,6 +48,14 @@ func (this *IOAttemptExec[A]) GetDebugInfo() *types.IODebugInfo {
 	return this.debugInfo
 }
 
+func (this *IOAttemptExec[A]) SetLogger(logger *slog.Logger) {
+	this.logger = logger
+}
+
+func (this *IOAttemptExec[A]) GetLogger() *slog.Logger {
+	return this.logger
+}
+
 func (this *IOAttemptExec[A]) TypeIn() reflect.Type {
 	return reflect.TypeFor[A]()
 }
@@ -84,8 +93,6 @@ func (this *IOAttemptExec[A]) UnsafeRun() types.IOEffect {
 	execute := true
 	hasPrev := prevEff.NonEmpty()
 
-	log.Printf("==>>>> %v\n", this.String())
-
 	if hasPrev {
 		prev := prevEff.Get()
 		if prev.GetResult().IsError() {
@@ -121,9 +128,6 @@ func (this *IOAttemptExec[A]) UnsafeRun() types.IOEffect {
 			}
 		}
 	}
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
 
 	return currEff.(types.IOEffect)
 }
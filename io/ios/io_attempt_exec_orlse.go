@@ -7,7 +7,7 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
-	"log"
+	"log/slog"
 	"reflect"
 )
 
@@ -23,6 +23,7 @@ type IOAttemptExecOrElse[A any] struct {
 	state     *state.State
 	debug     bool
 	debugInfo *types.IODebugInfo
+	logger    *slog.Logger
 }
 
 func NewAttemptExecOrElse[A any](f func()) *IOAttemptExecOrElse[A] {
@@ -57,6 +58,14 @@ func (this *IOAttemptExecOrElse[A]) GetDebugInfo() *types.IODebugInfo {
 	return this.debugInfo
 }
 
+func (this *IOAttemptExecOrElse[A]) SetLogger(logger *slog.Logger) {
+	this.logger = logger
+}
+
+func (this *IOAttemptExecOrElse[A]) GetLogger() *slog.Logger {
+	return this.logger
+}
+
 func (this *IOAttemptExecOrElse[A]) TypeIn() reflect.Type {
 	return reflect.TypeFor[*unit.Unit]()
 }
@@ -130,9 +139,6 @@ func (this *IOAttemptExecOrElse[A]) UnsafeRun() types.IOEffect {
 			}
 		}
 	}
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
 
 	return currEff.(types.IOEffect)
 }
@@ -8,7 +8,7 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
+	"log/slog"
 	"reflect"
 )
 
@@ -21,6 +21,7 @@ type IOAttemptFlatMap[A, B any] struct {
 	state     *state.State
 	debug     bool
 	debugInfo *types.IODebugInfo
+	logger    *slog.Logger
 }
 
 func NewAttemptFlatMap[A, B any](f func(A, *state.State) *types.IO[B]) *IOAttemptFlatMap[A, B] {
@@ -43,6 +44,14 @@ func (this *IOAttemptFlatMap[A, B]) GetDebugInfo() *types.IODebugInfo {
 	return this.debugInfo
 }
 
+func (this *IOAttemptFlatMap[A, B]) SetLogger(logger *slog.Logger) {
+	this.logger = logger
+}
+
+func (this *IOAttemptFlatMap[A, B]) GetLogger() *slog.Logger {
+	return this.logger
+}
+
 func (this *IOAttemptFlatMap[A, B]) TypeIn() reflect.Type {
 	return reflect.TypeFor[A]()
 }
@@ -111,9 +120,6 @@ func (this *IOAttemptFlatMap[A, B]) UnsafeRun() types.IOEffect {
 		}
 
 	}
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
 
 	return currEff.(types.IOEffect)
 }
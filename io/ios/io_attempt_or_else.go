@@ -8,7 +8,7 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
-	"log"
+	"log/slog"
 	"reflect"
 )
 
@@ -27,6 +27,7 @@ type IOAttemptOrElse[A any] struct {
 	state     *state.State
 	debug     bool
 	debugInfo *types.IODebugInfo
+	logger    *slog.Logger
 }
 
 func NewAttemptOrElseWithState[A any](f func(*state.State) *types.IO[A]) *IOAttemptOrElse[A] {
@@ -69,6 +70,14 @@ func (this *IOAttemptOrElse[A]) GetDebugInfo() *types.IODebugInfo {
 	return this.debugInfo
 }
 
+func (this *IOAttemptOrElse[A]) SetLogger(logger *slog.Logger) {
+	this.logger = logger
+}
+
+func (this *IOAttemptOrElse[A]) GetLogger() *slog.Logger {
+	return this.logger
+}
+
 func (this *IOAttemptOrElse[A]) TypeIn() reflect.Type {
 	return reflect.TypeFor[*unit.Unit]()
 }
@@ -165,10 +174,6 @@ func (this *IOAttemptOrElse[A]) UnsafeRun() types.IOEffect {
 		}
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
 
@@ -8,7 +8,7 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
+	"log/slog"
 	"reflect"
 )
 
@@ -28,6 +28,7 @@ type IOAttemptThen[A any] struct {
 	state     *state.State
 	debug     bool
 	debugInfo *types.IODebugInfo
+	logger    *slog.Logger
 }
 
 func NewAttemptThen[A any](f func(A) *result.Result[A]) *IOAttemptThen[A] {
@@ -70,6 +71,14 @@ func (this *IOAttemptThen[A]) GetDebugInfo() *types.IODebugInfo {
 	return this.debugInfo
 }
 
+func (this *IOAttemptThen[A]) SetLogger(logger *slog.Logger) {
+	this.logger = logger
+}
+
+func (this *IOAttemptThen[A]) GetLogger() *slog.Logger {
+	return this.logger
+}
+
 func (this *IOAttemptThen[A]) TypeIn() reflect.Type {
 	return reflect.TypeFor[A]()
 }
@@ -169,9 +178,6 @@ func (this *IOAttemptThen[A]) UnsafeRun() types.IOEffect {
 		}
 
 	}
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
 
 	return currEff.(types.IOEffect)
 }
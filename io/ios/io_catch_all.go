@@ -6,7 +6,6 @@ import (
 	"github.com/mobilemindtech/go-io/result"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
 	"reflect"
 )
 
@@ -86,10 +85,5 @@ func (this *IOCatchAll[A]) UnsafeRun() types.IOEffect {
 		}
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-
-	}
-
 	return currEff.(types.IOEffect)
 }
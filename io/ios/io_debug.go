@@ -2,11 +2,12 @@ package ios
 
 import (
 	"fmt"
+	"github.com/mobilemindtech/go-io/logging"
 	"github.com/mobilemindtech/go-io/option"
 	"github.com/mobilemindtech/go-io/result"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
+	"log/slog"
 	"reflect"
 )
 
@@ -16,6 +17,7 @@ type IODebug[A any] struct {
 	label      string
 	debug      bool
 	debugInfo  *types.IODebugInfo
+	logger     *slog.Logger
 }
 
 func NewDebug[A any](label string) *IODebug[A] {
@@ -50,6 +52,22 @@ func (this *IODebug[T]) GetDebugInfo() *types.IODebugInfo {
 	return this.debugInfo
 }
 
+func (this *IODebug[A]) SetLogger(logger *slog.Logger) {
+	this.logger = logger
+}
+
+func (this *IODebug[A]) GetLogger() *slog.Logger {
+	return this.logger
+}
+
+func (this *IODebug[A]) log() *slog.Logger {
+	logger := logging.Or(this.logger)
+	if this.debugInfo != nil {
+		logger = logger.With(logging.Caller(this.debugInfo.Filename, this.debugInfo.Line))
+	}
+	return logger
+}
+
 func (this *IODebug[A]) SetPrevEffect(prev types.IOEffect) {
 	this.prevEffect = prev
 }
@@ -69,7 +87,7 @@ func (this *IODebug[A]) UnsafeRun() types.IOEffect {
 
 	if prevEff.NonEmpty() {
 		r := prevEff.Get().GetResult()
-		log.Printf("<DEBUG>: %v - %v\n", this.label, prevEff.Get())
+		this.log().Info(this.label, "result", prevEff.Get())
 		if r.IsError() {
 			this.value = result.OfError[*option.Option[A]](r.Failure())
 		} else if r.Get().NonEmpty() {
@@ -83,11 +101,7 @@ func (this *IODebug[A]) UnsafeRun() types.IOEffect {
 			}
 		}
 	} else {
-		log.Printf("<DEBUG>: %v - IO(empty)\n", this.label)
-	}
-
-	if this.debug {
-		log.Printf("%v\n", this.String())
+		this.log().Info(this.label, "result", "IO(empty)")
 	}
 
 	return currEff.(types.IOEffect)
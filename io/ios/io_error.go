@@ -6,7 +6,6 @@ import (
 	"github.com/mobilemindtech/go-io/result"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
-	"log"
 	"reflect"
 )
 
@@ -66,9 +65,5 @@ func (this *IOError[A]) GetResult() types.ResultOptionAny {
 func (this *IOError[A]) UnsafeRun() types.IOEffect {
 	var currEff interface{} = this
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
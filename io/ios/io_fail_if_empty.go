@@ -7,7 +7,6 @@ import (
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
 	"reflect"
 )
 
@@ -96,9 +95,5 @@ func (this *IOFailIfEmpty[A]) UnsafeRun() types.IOEffect {
 		}
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
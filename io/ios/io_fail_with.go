@@ -5,7 +5,6 @@ import (
 	"github.com/mobilemindtech/go-io/option"
 	"github.com/mobilemindtech/go-io/result"
 	"github.com/mobilemindtech/go-io/types"
-	"log"
 	"reflect"
 )
 
@@ -76,9 +75,5 @@ func (this *IOFailWith[A]) UnsafeRun() types.IOEffect {
 		}
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
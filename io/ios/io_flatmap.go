@@ -130,9 +130,5 @@ func (this *IOFlatMap[A, B]) UnsafeRun() types.IOEffect {
 
 	}
 
-	if this.debug {
-		fmt.Println(this)
-	}
-
 	return currEff.(types.IOEffect)
 }
@@ -0,0 +1,108 @@
+// Code generated by go-io/internal/gen. DO NOT EDIT.
+
+package ios
+
+import (
+	"fmt"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/runtime"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/mobilemindtech/go-io/types"
+	"github.com/mobilemindtech/go-io/types/unit"
+	"reflect"
+)
+
+type IOFlatMap6[A, B, C, D, E, F, T any] struct {
+	value      *result.Result[*option.Option[T]]
+	prevEffect types.IOEffect
+	f          func(A, B, C, D, E, F) *types.IO[T]
+	ioA        *types.IO[A]
+	ioB        *types.IO[B]
+	ioC        *types.IO[C]
+	ioD        *types.IO[D]
+	ioE        *types.IO[E]
+	ioF        *types.IO[F]
+	debug      bool
+	state      *state.State
+	debugInfo  *types.IODebugInfo
+}
+
+func NewFlatMap6[A, B, C, D, E, F, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], f func(A, B, C, D, E, F) *types.IO[T]) *IOFlatMap6[A, B, C, D, E, F, T] {
+	return &IOFlatMap6[A, B, C, D, E, F, T]{f: f, ioA: ioA, ioB: ioB, ioC: ioC, ioD: ioD, ioE: ioE, ioF: ioF}
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) Lift() *types.IO[T] {
+	return types.NewIO[T]().Effects(this)
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) SetState(st *state.State) {
+	this.state = st
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) TypeIn() reflect.Type {
+	return reflect.TypeFor[*unit.Unit]()
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) TypeOut() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) SetDebug(b bool) {
+	this.debug = b
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) SetDebugInfo(info *types.IODebugInfo) {
+	this.debugInfo = info
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) GetDebugInfo() *types.IODebugInfo {
+	return this.debugInfo
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) String() string {
+	return fmt.Sprintf("FlatMap6(%v)", this.value.String())
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) SetPrevEffect(prev types.IOEffect) {
+	this.prevEffect = prev
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) GetPrevEffect() *option.Option[types.IOEffect] {
+	return option.Of(this.prevEffect)
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) GetResult() types.ResultOptionAny {
+	return this.value.ToResultOfOption()
+}
+
+func (this *IOFlatMap6[A, B, C, D, E, F, T]) UnsafeRun() types.IOEffect {
+	var currEff interface{} = this
+	prevEff := this.GetPrevEffect()
+	this.value = result.OfValue(option.None[T]())
+	execute := true
+
+	if prevEff.NonEmpty() {
+		r := prevEff.Get().GetResult()
+
+		if r.IsError() {
+			this.value = result.OfError[*option.Option[T]](r.Failure())
+			execute = false
+		} else if r.Get().Empty() {
+			execute = false
+		}
+	}
+
+	if execute {
+		runnableIO := NewFlatMap5[A, B, C, D, E, T](
+			this.ioA, this.ioB, this.ioC, this.ioD, this.ioE, func(a A, b B, c C, d D, e E) *types.IO[T] {
+				return NewFlatMapIO[F, T](
+					this.ioF, func(f F) *types.IO[T] {
+						return this.f(a, b, c, d, e, f)
+					}).Lift()
+			}).Lift()
+		this.value = runtime.NewWithState[T](this.state, runnableIO).UnsafeRun()
+	}
+
+	return currEff.(types.IOEffect)
+}
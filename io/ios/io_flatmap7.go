@@ -0,0 +1,109 @@
+// Code generated by go-io/internal/gen. DO NOT EDIT.
+
+package ios
+
+import (
+	"fmt"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/runtime"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/mobilemindtech/go-io/types"
+	"github.com/mobilemindtech/go-io/types/unit"
+	"reflect"
+)
+
+type IOFlatMap7[A, B, C, D, E, F, G, T any] struct {
+	value      *result.Result[*option.Option[T]]
+	prevEffect types.IOEffect
+	f          func(A, B, C, D, E, F, G) *types.IO[T]
+	ioA        *types.IO[A]
+	ioB        *types.IO[B]
+	ioC        *types.IO[C]
+	ioD        *types.IO[D]
+	ioE        *types.IO[E]
+	ioF        *types.IO[F]
+	ioG        *types.IO[G]
+	debug      bool
+	state      *state.State
+	debugInfo  *types.IODebugInfo
+}
+
+func NewFlatMap7[A, B, C, D, E, F, G, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G], f func(A, B, C, D, E, F, G) *types.IO[T]) *IOFlatMap7[A, B, C, D, E, F, G, T] {
+	return &IOFlatMap7[A, B, C, D, E, F, G, T]{f: f, ioA: ioA, ioB: ioB, ioC: ioC, ioD: ioD, ioE: ioE, ioF: ioF, ioG: ioG}
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) Lift() *types.IO[T] {
+	return types.NewIO[T]().Effects(this)
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) SetState(st *state.State) {
+	this.state = st
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) TypeIn() reflect.Type {
+	return reflect.TypeFor[*unit.Unit]()
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) TypeOut() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) SetDebug(b bool) {
+	this.debug = b
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) SetDebugInfo(info *types.IODebugInfo) {
+	this.debugInfo = info
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) GetDebugInfo() *types.IODebugInfo {
+	return this.debugInfo
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) String() string {
+	return fmt.Sprintf("FlatMap7(%v)", this.value.String())
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) SetPrevEffect(prev types.IOEffect) {
+	this.prevEffect = prev
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) GetPrevEffect() *option.Option[types.IOEffect] {
+	return option.Of(this.prevEffect)
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) GetResult() types.ResultOptionAny {
+	return this.value.ToResultOfOption()
+}
+
+func (this *IOFlatMap7[A, B, C, D, E, F, G, T]) UnsafeRun() types.IOEffect {
+	var currEff interface{} = this
+	prevEff := this.GetPrevEffect()
+	this.value = result.OfValue(option.None[T]())
+	execute := true
+
+	if prevEff.NonEmpty() {
+		r := prevEff.Get().GetResult()
+
+		if r.IsError() {
+			this.value = result.OfError[*option.Option[T]](r.Failure())
+			execute = false
+		} else if r.Get().Empty() {
+			execute = false
+		}
+	}
+
+	if execute {
+		runnableIO := NewFlatMap6[A, B, C, D, E, F, T](
+			this.ioA, this.ioB, this.ioC, this.ioD, this.ioE, this.ioF, func(a A, b B, c C, d D, e E, f F) *types.IO[T] {
+				return NewFlatMapIO[G, T](
+					this.ioG, func(g G) *types.IO[T] {
+						return this.f(a, b, c, d, e, f, g)
+					}).Lift()
+			}).Lift()
+		this.value = runtime.NewWithState[T](this.state, runnableIO).UnsafeRun()
+	}
+
+	return currEff.(types.IOEffect)
+}
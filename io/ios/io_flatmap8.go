@@ -0,0 +1,110 @@
+// Code generated by go-io/internal/gen. DO NOT EDIT.
+
+package ios
+
+import (
+	"fmt"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/runtime"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/mobilemindtech/go-io/types"
+	"github.com/mobilemindtech/go-io/types/unit"
+	"reflect"
+)
+
+type IOFlatMap8[A, B, C, D, E, F, G, H, T any] struct {
+	value      *result.Result[*option.Option[T]]
+	prevEffect types.IOEffect
+	f          func(A, B, C, D, E, F, G, H) *types.IO[T]
+	ioA        *types.IO[A]
+	ioB        *types.IO[B]
+	ioC        *types.IO[C]
+	ioD        *types.IO[D]
+	ioE        *types.IO[E]
+	ioF        *types.IO[F]
+	ioG        *types.IO[G]
+	ioH        *types.IO[H]
+	debug      bool
+	state      *state.State
+	debugInfo  *types.IODebugInfo
+}
+
+func NewFlatMap8[A, B, C, D, E, F, G, H, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G], ioH *types.IO[H], f func(A, B, C, D, E, F, G, H) *types.IO[T]) *IOFlatMap8[A, B, C, D, E, F, G, H, T] {
+	return &IOFlatMap8[A, B, C, D, E, F, G, H, T]{f: f, ioA: ioA, ioB: ioB, ioC: ioC, ioD: ioD, ioE: ioE, ioF: ioF, ioG: ioG, ioH: ioH}
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) Lift() *types.IO[T] {
+	return types.NewIO[T]().Effects(this)
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) SetState(st *state.State) {
+	this.state = st
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) TypeIn() reflect.Type {
+	return reflect.TypeFor[*unit.Unit]()
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) TypeOut() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) SetDebug(b bool) {
+	this.debug = b
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) SetDebugInfo(info *types.IODebugInfo) {
+	this.debugInfo = info
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) GetDebugInfo() *types.IODebugInfo {
+	return this.debugInfo
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) String() string {
+	return fmt.Sprintf("FlatMap8(%v)", this.value.String())
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) SetPrevEffect(prev types.IOEffect) {
+	this.prevEffect = prev
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) GetPrevEffect() *option.Option[types.IOEffect] {
+	return option.Of(this.prevEffect)
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) GetResult() types.ResultOptionAny {
+	return this.value.ToResultOfOption()
+}
+
+func (this *IOFlatMap8[A, B, C, D, E, F, G, H, T]) UnsafeRun() types.IOEffect {
+	var currEff interface{} = this
+	prevEff := this.GetPrevEffect()
+	this.value = result.OfValue(option.None[T]())
+	execute := true
+
+	if prevEff.NonEmpty() {
+		r := prevEff.Get().GetResult()
+
+		if r.IsError() {
+			this.value = result.OfError[*option.Option[T]](r.Failure())
+			execute = false
+		} else if r.Get().Empty() {
+			execute = false
+		}
+	}
+
+	if execute {
+		runnableIO := NewFlatMap7[A, B, C, D, E, F, G, T](
+			this.ioA, this.ioB, this.ioC, this.ioD, this.ioE, this.ioF, this.ioG, func(a A, b B, c C, d D, e E, f F, g G) *types.IO[T] {
+				return NewFlatMapIO[H, T](
+					this.ioH, func(h H) *types.IO[T] {
+						return this.f(a, b, c, d, e, f, g, h)
+					}).Lift()
+			}).Lift()
+		this.value = runtime.NewWithState[T](this.state, runnableIO).UnsafeRun()
+	}
+
+	return currEff.(types.IOEffect)
+}
@@ -0,0 +1,111 @@
+// Code generated by go-io/internal/gen. DO NOT EDIT.
+
+package ios
+
+import (
+	"fmt"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/runtime"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/mobilemindtech/go-io/types"
+	"github.com/mobilemindtech/go-io/types/unit"
+	"reflect"
+)
+
+type IOFlatMap9[A, B, C, D, E, F, G, H, I, T any] struct {
+	value      *result.Result[*option.Option[T]]
+	prevEffect types.IOEffect
+	f          func(A, B, C, D, E, F, G, H, I) *types.IO[T]
+	ioA        *types.IO[A]
+	ioB        *types.IO[B]
+	ioC        *types.IO[C]
+	ioD        *types.IO[D]
+	ioE        *types.IO[E]
+	ioF        *types.IO[F]
+	ioG        *types.IO[G]
+	ioH        *types.IO[H]
+	ioI        *types.IO[I]
+	debug      bool
+	state      *state.State
+	debugInfo  *types.IODebugInfo
+}
+
+func NewFlatMap9[A, B, C, D, E, F, G, H, I, T any](ioA *types.IO[A], ioB *types.IO[B], ioC *types.IO[C], ioD *types.IO[D], ioE *types.IO[E], ioF *types.IO[F], ioG *types.IO[G], ioH *types.IO[H], ioI *types.IO[I], f func(A, B, C, D, E, F, G, H, I) *types.IO[T]) *IOFlatMap9[A, B, C, D, E, F, G, H, I, T] {
+	return &IOFlatMap9[A, B, C, D, E, F, G, H, I, T]{f: f, ioA: ioA, ioB: ioB, ioC: ioC, ioD: ioD, ioE: ioE, ioF: ioF, ioG: ioG, ioH: ioH, ioI: ioI}
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) Lift() *types.IO[T] {
+	return types.NewIO[T]().Effects(this)
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) SetState(st *state.State) {
+	this.state = st
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) TypeIn() reflect.Type {
+	return reflect.TypeFor[*unit.Unit]()
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) TypeOut() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) SetDebug(b bool) {
+	this.debug = b
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) SetDebugInfo(info *types.IODebugInfo) {
+	this.debugInfo = info
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) GetDebugInfo() *types.IODebugInfo {
+	return this.debugInfo
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) String() string {
+	return fmt.Sprintf("FlatMap9(%v)", this.value.String())
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) SetPrevEffect(prev types.IOEffect) {
+	this.prevEffect = prev
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) GetPrevEffect() *option.Option[types.IOEffect] {
+	return option.Of(this.prevEffect)
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) GetResult() types.ResultOptionAny {
+	return this.value.ToResultOfOption()
+}
+
+func (this *IOFlatMap9[A, B, C, D, E, F, G, H, I, T]) UnsafeRun() types.IOEffect {
+	var currEff interface{} = this
+	prevEff := this.GetPrevEffect()
+	this.value = result.OfValue(option.None[T]())
+	execute := true
+
+	if prevEff.NonEmpty() {
+		r := prevEff.Get().GetResult()
+
+		if r.IsError() {
+			this.value = result.OfError[*option.Option[T]](r.Failure())
+			execute = false
+		} else if r.Get().Empty() {
+			execute = false
+		}
+	}
+
+	if execute {
+		runnableIO := NewFlatMap8[A, B, C, D, E, F, G, H, T](
+			this.ioA, this.ioB, this.ioC, this.ioD, this.ioE, this.ioF, this.ioG, this.ioH, func(a A, b B, c C, d D, e E, f F, g G, h H) *types.IO[T] {
+				return NewFlatMapIO[I, T](
+					this.ioI, func(i I) *types.IO[T] {
+						return this.f(a, b, c, d, e, f, g, h, i)
+					}).Lift()
+			}).Lift()
+		this.value = runtime.NewWithState[T](this.state, runnableIO).UnsafeRun()
+	}
+
+	return currEff.(types.IOEffect)
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
-	"log"
 	"reflect"
 )
 
@@ -39,6 +38,10 @@ func (this *IOLoadVar[A]) SetState(st *state.State) {
 	this.state = st
 }
 
+func (this *IOLoadVar[A]) StateReads() []reflect.Type {
+	return []reflect.Type{reflect.TypeFor[A]()}
+}
+
 func (this *IOLoadVar[A]) SetDebug(b bool) {
 	this.debug = b
 }
@@ -85,9 +88,5 @@ func (this *IOLoadVar[A]) UnsafeRun() types.IOEffect {
 		this.value = result.OfValue(option.Some(state.Var[A](this.state)))
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
@@ -7,7 +7,6 @@ import (
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
 	"reflect"
 )
 
@@ -80,9 +79,5 @@ func (this *IONohup[T]) UnsafeRun() types.IOEffect {
 		this.value = result.OfValue(option.Some(util.NewOf[T]()))
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
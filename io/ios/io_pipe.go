@@ -8,7 +8,6 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
-	"log"
 	"reflect"
 )
 
@@ -65,6 +64,10 @@ func (this *IOPipe[A, T]) SetState(st *state.State) {
 	this.state = st
 }
 
+func (this *IOPipe[A, T]) StateReads() []reflect.Type {
+	return []reflect.Type{reflect.TypeFor[A]()}
+}
+
 func (this *IOPipe[A, T]) SetDebugInfo(info *types.IODebugInfo) {
 	this.debugInfo = info
 }
@@ -120,9 +123,5 @@ func (this *IOPipe[A, T]) UnsafeRun() types.IOEffect {
 		}
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
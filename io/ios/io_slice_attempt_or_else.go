@@ -7,7 +7,7 @@ import (
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
+	"log/slog"
 	"reflect"
 	"runtime/debug"
 )
@@ -19,6 +19,7 @@ type IOSliceAttemptOrElse[A any] struct {
 	fstate     func(*state.State) *result.Result[[]A]
 	debug      bool
 	debugInfo  *types.IODebugInfo
+	logger     *slog.Logger
 	state      *state.State
 }
 
@@ -58,6 +59,14 @@ func (this *IOSliceAttemptOrElse[A]) GetDebugInfo() *types.IODebugInfo {
 	return this.debugInfo
 }
 
+func (this *IOSliceAttemptOrElse[A]) SetLogger(logger *slog.Logger) {
+	this.logger = logger
+}
+
+func (this *IOSliceAttemptOrElse[A]) GetLogger() *slog.Logger {
+	return this.logger
+}
+
 func (this *IOSliceAttemptOrElse[A]) String() string {
 	return fmt.Sprintf("SliceAttemptOrElse(%v)", this.value.String())
 }
@@ -91,11 +100,7 @@ func (this *IOSliceAttemptOrElse[A]) UnsafeRun() types.IOEffect {
 					err := types.NewIOError(fmt.Sprintf("%v", r), debug.Stack())
 
 					if this.debug {
-						if this.debugInfo != nil {
-							log.Printf("[DEBUG IOSliceAttemptOrElse]=>> added in: %v:%v", this.debugInfo.Filename, this.debugInfo.Line)
-						}
-						log.Printf("[DEBUG IOSliceAttemptOrElse]=>> Error: %v\n", err.Error())
-						log.Printf("[DEBUG IOSliceAttemptOrElse]=>> StackTrace: %v\n", err.StackTrace)
+						logPanic(this.logger, "IOSliceAttemptOrElse", this.debugInfo, err)
 					}
 
 					this.value = result.OfError[*option.Option[[]A]](err)
@@ -128,9 +133,5 @@ func (this *IOSliceAttemptOrElse[A]) UnsafeRun() types.IOEffect {
 		}
 	}
 
-	if this.debug {
-		log.Printf("%v\n", this.String())
-	}
-
 	return currEff.(types.IOEffect)
 }
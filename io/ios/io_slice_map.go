@@ -6,7 +6,6 @@ import (
 	"github.com/mobilemindtech/go-io/result"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
 	"reflect"
 )
 
@@ -87,9 +86,5 @@ func (this *IOSliceMap[A, B]) UnsafeRun() types.IOEffect {
 		}
 	}
 
-	//if this.debug {
-	log.Printf("%v\n", this.String())
-	//}
-
 	return currEff.(types.IOEffect)
 }
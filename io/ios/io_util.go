@@ -2,13 +2,15 @@ package ios
 
 import (
 	"fmt"
+	"github.com/mobilemindtech/go-io/logging"
 	"github.com/mobilemindtech/go-io/option"
 	"github.com/mobilemindtech/go-io/result"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
+	"log/slog"
 	"reflect"
 	"runtime/debug"
+	"strings"
 )
 
 func TryGetLastIOResult[A any](refIO interface{}, prevEff *option.Option[types.IOEffect]) *result.Result[*option.Option[A]] {
@@ -31,19 +33,27 @@ func RecoverIO[A any](refIO interface{}, isDebug bool, debugInfo *types.IODebugI
 	errIO := types.NewIOError(fmt.Sprintf("%v", err), debug.Stack())
 
 	if isDebug {
-
-		ioName := reflect.TypeOf(refIO).Elem().Name()
-
-		if debugInfo != nil {
-			log.Printf("[DEBUG %v]=>> added in: %v:%v", ioName, debugInfo.Filename, debugInfo.Line)
+		ioName, _, _ := strings.Cut(reflect.TypeOf(refIO).Elem().Name(), "[")
+		var logger *slog.Logger
+		if lg, ok := refIO.(types.IOLoggable); ok {
+			logger = lg.GetLogger()
 		}
-		log.Printf("[DEBUG %v]=>> Error: %v\n", ioName, errIO.Error())
-		log.Printf("[DEBUG %v]=>> StackTrace: %v\n", ioName, errIO.StackTrace)
+		logPanic(logger, ioName, debugInfo, errIO)
 	}
 
 	return result.OfError[*option.Option[A]](errIO)
 }
 
+// logPanic log recovered panic of effect when debug is on. Effects without
+// logger use the library logger
+func logPanic(logger *slog.Logger, effect string, debugInfo *types.IODebugInfo, err *types.IOError) {
+	logger = logging.Or(logger).With(logging.KeyEffect, effect)
+	if debugInfo != nil {
+		logger = logger.With(logging.Caller(debugInfo.Filename, debugInfo.Line))
+	}
+	logger.Info("panic", logging.KeyError, err.Error(), logging.KeyStack, err.StackTrace)
+}
+
 func ResultToResultOption[T any](res *result.Result[T]) *result.Result[*option.Option[T]] {
 	if res.IsError() {
 		return result.OfError[*option.Option[T]](res.GetError())
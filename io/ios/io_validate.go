@@ -0,0 +1,95 @@
+package ios
+
+import (
+	"fmt"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/types"
+	"github.com/mobilemindtech/go-io/util"
+	"github.com/mobilemindtech/go-io/validation"
+	"reflect"
+)
+
+type IOValidate[A any] struct {
+	value      *result.Result[*option.Option[A]]
+	prevEffect types.IOEffect
+	validator  func(A) *validation.V[A]
+	debug      bool
+	debugInfo  *types.IODebugInfo
+}
+
+func NewValidate[A any](validator func(A) *validation.V[A]) *IOValidate[A] {
+	return &IOValidate[A]{validator: validator}
+}
+
+func (this *IOValidate[A]) Lift() *types.IO[A] {
+	return types.NewIO[A]().Effects(this)
+}
+
+func (this *IOValidate[T]) TypeIn() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (this *IOValidate[T]) TypeOut() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (this *IOValidate[A]) SetDebug(b bool) {
+	this.debug = b
+}
+
+func (this *IOValidate[T]) SetDebugInfo(info *types.IODebugInfo) {
+	this.debugInfo = info
+}
+
+func (this *IOValidate[T]) GetDebugInfo() *types.IODebugInfo {
+	return this.debugInfo
+}
+
+func (this *IOValidate[A]) String() string {
+	return fmt.Sprintf("Validate(%v)", this.value.String())
+}
+
+func (this *IOValidate[A]) SetPrevEffect(prev types.IOEffect) {
+	this.prevEffect = prev
+}
+
+func (this *IOValidate[A]) GetPrevEffect() *option.Option[types.IOEffect] {
+	return option.Of(this.prevEffect)
+}
+
+func (this *IOValidate[A]) GetResult() types.ResultOptionAny {
+	return this.value.ToResultOfOption()
+}
+
+func (this *IOValidate[A]) UnsafeRun() types.IOEffect {
+	var currEff interface{} = this
+	prevEff := this.GetPrevEffect()
+	this.value = result.OfValue(option.None[A]())
+
+	if prevEff.NonEmpty() {
+		r := prevEff.Get().GetResult()
+
+		if r.IsError() {
+			this.value = result.OfError[*option.Option[A]](r.Failure())
+		} else if r.Get().NonEmpty() {
+			val := r.Get().GetValue()
+			if effValue, ok := val.(A); ok {
+
+				validated := this.validator(effValue)
+
+				if validated.IsInvalid() {
+					this.value = result.OfError[*option.Option[A]](validated.Failure())
+				} else {
+					this.value = result.OfValue(option.Some(validated.Get()))
+				}
+
+			} else {
+				util.PanicCastType("Validate",
+					reflect.TypeOf(val), reflect.TypeFor[A]())
+			}
+		}
+	}
+
+	return currEff.(types.IOEffect)
+}
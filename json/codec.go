@@ -2,8 +2,9 @@ package json
 
 import (
 	"encoding/json"
-	"github.com/mobilemindtech/go-io/result"
 	"reflect"
+
+	"github.com/mobilemindtech/go-io/result"
 )
 
 type JsonEncoder[T any] struct {
@@ -13,39 +14,65 @@ func NewJsonEncoder[T any]() *JsonEncoder[T] {
 	return &JsonEncoder[T]{}
 }
 
+// Encode data. Fields tagged omitempty are omitted also when they are None options
+// or other values that report IsZero, that encoding/json keeps
 func (this *JsonEncoder[T]) Encode(data T) *result.Result[[]byte] {
 	return result.Try(func() ([]byte, error) {
-		return json.Marshal(data)
+		return json.Marshal(omitEmpty(reflect.ValueOf(data)))
 	})
 }
 
 type JsonDecoder[T any] struct {
+	options decodeOptions
 }
 
 func NewJsonDecoder[T any]() *JsonDecoder[T] {
 	return &JsonDecoder[T]{}
 }
 
+// Strict fail on unknown fields
+func (this *JsonDecoder[T]) Strict() *JsonDecoder[T] {
+	this.options.strict = true
+	return this
+}
+
+// Validated run struct validation (validate tag) after decode
+func (this *JsonDecoder[T]) Validated() *JsonDecoder[T] {
+	this.options.validated = true
+	return this
+}
+
 func (this *JsonDecoder[T]) Decode(data []byte) *result.Result[T] {
 	return result.Try(func() (T, error) {
-		typOf := reflect.TypeFor[T]()
-		if typOf.Kind() == reflect.Pointer {
-			typOf = typOf.Elem()
-			val := reflect.New(typOf).Interface()
-			return val.(T), json.Unmarshal(data, val)
-		} else {
-			val := reflect.New(typOf).Elem().Interface().(T)
-			return val, json.Unmarshal(data, &val)
-		}
+		val := new(T)
+		err := this.decode(data, newTarget(val), val)
+		return *val, err
 	})
 }
 
 func (this *JsonDecoder[T]) DecodeTo(data []byte, entity T) *result.Result[T] {
 	return result.Try(func() (T, error) {
-		return entity, json.Unmarshal(data, entity)
+		return entity, this.decode(data, entity, &entity)
 	})
 }
 
+func (this *JsonDecoder[T]) decode(data []byte, target any, val *T) error {
+	if offset, err := this.options.decode(data, target); err != nil {
+		return newDecodeError(err, "$", 0, offset)
+	}
+	return validate(this.options, *val)
+}
+
+// newTarget decode target of val. Pointer types are allocated
+func newTarget[T any](val *T) any {
+	typOf := reflect.TypeFor[T]()
+	if typOf.Kind() == reflect.Pointer {
+		*val = reflect.New(typOf.Elem()).Interface().(T)
+		return *val
+	}
+	return val
+}
+
 func Decode[T any](data []byte) *result.Result[T] {
 	return NewJsonDecoder[T]().Decode(data)
 }
@@ -54,6 +81,16 @@ func DecodeTo[T any](data []byte, entity T) *result.Result[T] {
 	return NewJsonDecoder[T]().DecodeTo(data, entity)
 }
 
+// DecodeStrict decode and fail on unknown fields
+func DecodeStrict[T any](data []byte) *result.Result[T] {
+	return NewJsonDecoder[T]().Strict().Decode(data)
+}
+
+// DecodeValidated decode and validate struct with validation.Struct
+func DecodeValidated[T any](data []byte) *result.Result[T] {
+	return NewJsonDecoder[T]().Validated().Decode(data)
+}
+
 func Encode[T any](entity T) *result.Result[[]byte] {
 	return NewJsonEncoder[T]().Encode(entity)
 }
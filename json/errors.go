@@ -0,0 +1,40 @@
+package json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var errTrailingData = errors.New("invalid data after top-level value")
+
+// DecodeError decode error with JSON path and input offset
+type DecodeError struct {
+	Path   string
+	Offset int64
+	Err    error
+}
+
+func (this *DecodeError) Error() string {
+	return fmt.Sprintf("json: %v at %v (offset %v)", this.Err, this.Path, this.Offset)
+}
+
+func (this *DecodeError) Unwrap() error {
+	return this.Err
+}
+
+// newDecodeError wrap decode error. Offset and field path are taken from
+// syntax and type errors when available, relative to base offset
+func newDecodeError(err error, path string, base int64, offset int64) *DecodeError {
+	var syntaxError *json.SyntaxError
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &syntaxError) {
+		offset = base + syntaxError.Offset
+	} else if errors.As(err, &typeError) {
+		offset = base + typeError.Offset
+		if typeError.Field != "" {
+			path = path + "." + typeError.Field
+		}
+	}
+	return &DecodeError{Path: path, Offset: offset, Err: err}
+}
@@ -0,0 +1,211 @@
+package json
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// zeroer is implemented by values that know if they are empty, like option.Option
+type zeroer interface {
+	IsZero() bool
+}
+
+var (
+	marshalerType     = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	zeroerType        = reflect.TypeFor[zeroer]()
+	anyType           = reflect.TypeFor[any]()
+	omitTypes         sync.Map // reflect.Type -> bool
+)
+
+// object is a struct encoded with the fields in declaration order
+type object []member
+
+type member struct {
+	name  string
+	value any
+}
+
+func (this object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range this {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(m.name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// omitEmpty value to encode without the omitempty fields that are empty, also the
+// ones encoding/json keeps, like None options and zero structs that report IsZero
+func omitEmpty(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	if !needsOmit(v.Type()) {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return omitEmpty(v.Elem())
+	case reflect.Struct:
+		return structObject(v, object{})
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		items := make([]any, v.Len())
+		for i := range items {
+			items[i] = omitEmpty(v.Index(i))
+		}
+		return items
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		m := reflect.MakeMapWithSize(reflect.MapOf(v.Type().Key(), anyType), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			value := reflect.ValueOf(omitEmpty(iter.Value()))
+			if !value.IsValid() {
+				value = reflect.Zero(anyType)
+			}
+			m.SetMapIndex(iter.Key(), value)
+		}
+		return m.Interface()
+	}
+	return v.Interface()
+}
+
+// structObject append fields of v to obj. Embedded structs without name are inlined
+func structObject(v reflect.Value, obj object) object {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+
+		fieldValue := v.Field(i)
+		if field.Anonymous && name == "" {
+			embedded := fieldValue
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				obj = structObject(embedded, obj)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if hasOption(opts, "omitempty") && isEmpty(fieldValue) {
+			continue
+		}
+		obj = append(obj, member{name: name, value: omitEmpty(fieldValue)})
+	}
+	return obj
+}
+
+// isEmpty check empty values of omitempty and values that report IsZero
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		if v.IsNil() {
+			return true
+		}
+		if v.Elem().Kind() != reflect.Struct {
+			return false
+		}
+		return isEmpty(v.Elem())
+	}
+	if z, ok := v.Interface().(zeroer); ok {
+		return z.IsZero()
+	}
+	return false
+}
+
+func hasOption(opts string, name string) bool {
+	for opts != "" {
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		if opt == name {
+			return true
+		}
+	}
+	return false
+}
+
+// needsOmit check if values of t have omitempty fields that encoding/json does not omit
+func needsOmit(t reflect.Type) bool {
+	if needs, ok := omitTypes.Load(t); ok {
+		return needs.(bool)
+	}
+	needs := checkOmit(t, map[reflect.Type]bool{})
+	omitTypes.Store(t, needs)
+	return needs
+}
+
+func checkOmit(t reflect.Type, visiting map[reflect.Type]bool) bool {
+	if visiting[t] || t.Implements(marshalerType) || t.Implements(textMarshalerType) {
+		return false
+	}
+	visiting[t] = true
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return checkOmit(t.Elem(), visiting)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			_, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if hasOption(opts, "omitempty") && fieldType.Kind() == reflect.Struct && fieldType.Implements(zeroerType) {
+				return true
+			}
+			if checkOmit(field.Type, visiting) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package json
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"unicode"
+
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/validation"
+)
+
+type decodeOptions struct {
+	strict    bool
+	validated bool
+}
+
+func (this decodeOptions) newDecoder(reader io.Reader) *json.Decoder {
+	dec := json.NewDecoder(reader)
+	if this.strict {
+		dec.DisallowUnknownFields()
+	}
+	return dec
+}
+
+// decode single value. Return decoder offset for errors
+func (this decodeOptions) decode(data []byte, target any) (int64, error) {
+	dec := this.newDecoder(bytes.NewReader(data))
+	if err := dec.Decode(target); err != nil {
+		return dec.InputOffset(), err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return dec.InputOffset(), errTrailingData
+	}
+	return 0, nil
+}
+
+func validate[T any](options decodeOptions, value T) error {
+	if options.validated {
+		if v := validation.Struct(value); v.IsInvalid() {
+			return v.Failure()
+		}
+	}
+	return nil
+}
+
+// Stream decode values from reader one at a time. A JSON array yield each
+// element, any other input is read as a sequence of values (NDJSON).
+// The reader is consumed, so a Stream can be iterated only once.
+type Stream[T any] struct {
+	reader  io.Reader
+	options decodeOptions
+}
+
+func NewStream[T any](reader io.Reader) *Stream[T] {
+	return &Stream[T]{reader: reader}
+}
+
+// Strict fail on unknown fields
+func (this *Stream[T]) Strict() *Stream[T] {
+	this.options.strict = true
+	return this
+}
+
+// Validated run struct validation (validate tag) for each value
+func (this *Stream[T]) Validated() *Stream[T] {
+	this.options.validated = true
+	return this
+}
+
+// All iterate decoded values. Iteration stops after first error
+func (this *Stream[T]) All() iter.Seq[*result.Result[T]] {
+	return func(yield func(*result.Result[T]) bool) {
+		reader := bufio.NewReader(this.reader)
+		isArray, err := startsWithArray(reader)
+		if err == io.EOF {
+			return
+		} else if err != nil {
+			yield(result.OfError[T](newDecodeError(err, "$", 0, 0)))
+			return
+		}
+
+		dec := this.options.newDecoder(reader)
+		if isArray {
+			if _, err := dec.Token(); err != nil {
+				yield(result.OfError[T](newDecodeError(err, "$", 0, dec.InputOffset())))
+				return
+			}
+		}
+
+		for i := 0; ; i++ {
+			if isArray && !dec.More() {
+				if _, err := dec.Token(); err != nil {
+					yield(result.OfError[T](newDecodeError(err, "$", 0, dec.InputOffset())))
+				}
+				return
+			}
+
+			var raw json.RawMessage
+			err := dec.Decode(&raw)
+			if err == io.EOF && !isArray {
+				return
+			}
+
+			path := fmt.Sprintf("$[%v]", i)
+			if err != nil {
+				yield(result.OfError[T](newDecodeError(err, path, 0, dec.InputOffset())))
+				return
+			}
+
+			// raw value offset, errors of value decode are relative to it
+			start := dec.InputOffset() - int64(len(raw))
+			val := new(T)
+			if offset, err := this.options.decode(raw, newTarget(val)); err != nil {
+				yield(result.OfError[T](newDecodeError(err, path, start, start+offset)))
+				return
+			}
+			if err := validate(this.options, *val); err != nil {
+				yield(result.OfError[T](&DecodeError{Path: path, Offset: start, Err: err}))
+				return
+			}
+			if !yield(result.OfValue(*val)) {
+				return
+			}
+		}
+	}
+}
+
+// Collect decode all values
+func (this *Stream[T]) Collect() *result.Result[[]T] {
+	values := []T{}
+	for res := range this.All() {
+		if res.IsError() {
+			return result.OfError[[]T](res.Failure())
+		}
+		values = append(values, res.Get())
+	}
+	return result.OfValue(values)
+}
+
+// startsWithArray peek leading spaces and report if input is a JSON array
+func startsWithArray(reader *bufio.Reader) (bool, error) {
+	for n := 1; ; n++ {
+		b, err := reader.Peek(n)
+		if err != nil {
+			return false, err
+		}
+		if c := b[n-1]; !unicode.IsSpace(rune(c)) {
+			return c == '[', nil
+		}
+	}
+}
+
+// StreamDecoder decode JSON arrays or NDJSON to slice. Can be used as http response decoder
+type StreamDecoder[T any] struct {
+	options decodeOptions
+}
+
+func NewStreamDecoder[T any]() *StreamDecoder[T] {
+	return &StreamDecoder[T]{}
+}
+
+// Strict fail on unknown fields
+func (this *StreamDecoder[T]) Strict() *StreamDecoder[T] {
+	this.options.strict = true
+	return this
+}
+
+// Validated run struct validation (validate tag) for each value
+func (this *StreamDecoder[T]) Validated() *StreamDecoder[T] {
+	this.options.validated = true
+	return this
+}
+
+func (this *StreamDecoder[T]) Decode(data []byte) *result.Result[[]T] {
+	stream := NewStream[T](bytes.NewReader(data))
+	stream.options = this.options
+	return stream.Collect()
+}
+
+// DecodeStream iterate values of a JSON array or NDJSON reader
+func DecodeStream[T any](reader io.Reader) iter.Seq[*result.Result[T]] {
+	return NewStream[T](reader).All()
+}
@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"os"
+	"sync"
+)
+
+// Attribute keys of library log records
+const (
+	KeyIO     = "io"
+	KeyApp    = "app"
+	KeyEffect = "effect"
+	KeyType   = "type"
+	KeyCaller = "caller"
+	KeyRunId  = "run_id"
+	KeyError  = "error"
+	KeyStack  = "stack"
+)
+
+var (
+	mu     sync.RWMutex
+	logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelInfo}))
+)
+
+// SetLogger set library logger. Runtimes and IOs without own logger use it.
+// Nil discard all output
+func SetLogger(l *slog.Logger) {
+	mu.Lock()
+	defer mu.Unlock()
+	if l == nil {
+		l = Discard()
+	}
+	logger = l
+}
+
+// SetHandler set library logger handler
+func SetHandler(h slog.Handler) {
+	SetLogger(slog.New(h))
+}
+
+// Logger library logger. Default is a text handler on stderr with info level.
+// Output of debug APIs, like Debug and DebugOn, is logged with info level when
+// debug is on for an IO, runtime or client
+func Logger() *slog.Logger {
+	mu.RLock()
+	defer mu.RUnlock()
+	return logger
+}
+
+// Or logger l, or library logger when l is nil
+func Or(l *slog.Logger) *slog.Logger {
+	if l != nil {
+		return l
+	}
+	return Logger()
+}
+
+func Discard() *slog.Logger {
+	return slog.New(slog.DiscardHandler)
+}
+
+// NewRunId random run id
+func NewRunId() string {
+	return fmt.Sprintf("%016x", rand.Uint64())
+}
+
+// Caller file:line attribute
+func Caller(filename string, line int) slog.Attr {
+	return slog.String(KeyCaller, fmt.Sprintf("%v:%v", filename, line))
+}
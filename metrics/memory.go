@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// InMemory keep counters and observations in memory. Useful for tests
+type InMemory struct {
+	mu           sync.Mutex
+	counters     map[string]float64
+	observations map[string][]float64
+}
+
+func NewInMemory() *InMemory {
+	return &InMemory{counters: map[string]float64{}, observations: map[string][]float64{}}
+}
+
+func (this *InMemory) Inc(name string, labels Labels) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.counters[seriesKey(name, labels)]++
+}
+
+func (this *InMemory) Observe(name string, value float64, labels Labels) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	key := seriesKey(name, labels)
+	this.observations[key] = append(this.observations[key], value)
+}
+
+// Counter value of counter with exactly these labels
+func (this *InMemory) Counter(name string, labels Labels) float64 {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return this.counters[seriesKey(name, labels)]
+}
+
+// Observations values of histogram with exactly these labels
+func (this *InMemory) Observations(name string, labels Labels) []float64 {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return slices.Clone(this.observations[seriesKey(name, labels)])
+}
+
+func (this *InMemory) Reset() {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	clear(this.counters)
+	clear(this.observations)
+}
+
+// seriesKey name and labels sorted by key, in Prometheus format
+func seriesKey(name string, labels Labels) string {
+	if len(labels) == 0 {
+		return name
+	}
+	var pairs []string
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		pairs = append(pairs, fmt.Sprintf("%v=\"%v\"", key, escapeLabel(labels[key])))
+	}
+	return name + "{" + strings.Join(pairs, ",") + "}"
+}
+
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Metric names reported by runtimes
+const (
+	Runs         = "io_runs_total"
+	RunDuration  = "io_run_duration_seconds"
+	StepDuration = "io_step_duration_seconds"
+	Failures     = "io_failures_total"
+	Panics       = "io_panics_total"
+	Empty        = "io_empty_total"
+)
+
+// Label keys
+const (
+	LabelRuntime   = "runtime"
+	LabelName      = "name"
+	LabelStep      = "step"
+	LabelErrorType = "error_type"
+)
+
+type Labels map[string]string
+
+// Metrics receive counters and histogram observations
+type Metrics interface {
+	Inc(name string, labels Labels)
+	Observe(name string, value float64, labels Labels)
+}
+
+var (
+	mu     sync.RWMutex
+	global Metrics
+)
+
+// SetMetrics set global metrics used by runtimes. Nil disable metrics
+func SetMetrics(m Metrics) {
+	mu.Lock()
+	defer mu.Unlock()
+	global = m
+}
+
+// Enabled report if global metrics is set
+func Enabled() bool {
+	return get() != nil
+}
+
+func get() Metrics {
+	mu.RLock()
+	defer mu.RUnlock()
+	return global
+}
+
+// ObserveRun report run of runtime with duration since start, failure by error type and empty outcome
+func ObserveRun(runtime string, name string, start time.Time, err error, empty bool) {
+	m := get()
+	if m == nil {
+		return
+	}
+	labels := Labels{LabelRuntime: runtime, LabelName: name}
+	m.Inc(Runs, labels)
+	m.Observe(RunDuration, time.Since(start).Seconds(), labels)
+	if err != nil {
+		m.Inc(Failures, Labels{LabelRuntime: runtime, LabelName: name, LabelErrorType: ErrorType(err)})
+	} else if empty {
+		m.Inc(Empty, labels)
+	}
+}
+
+// ObserveStep report step duration since start
+func ObserveStep(runtime string, name string, step string, start time.Time) {
+	if m := get(); m != nil {
+		m.Observe(StepDuration, time.Since(start).Seconds(),
+			Labels{LabelRuntime: runtime, LabelName: name, LabelStep: step})
+	}
+}
+
+// IncPanic report recovered panic
+func IncPanic(runtime string, name string) {
+	if m := get(); m != nil {
+		m.Inc(Panics, Labels{LabelRuntime: runtime, LabelName: name})
+	}
+}
+
+// ErrorType error type name, e.g. *errors.errorString
+func ErrorType(err error) string {
+	return reflect.TypeOf(err).String()
+}
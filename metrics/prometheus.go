@@ -0,0 +1,138 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// DefaultBuckets histogram buckets in seconds
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+type histogram struct {
+	labels Labels
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+type counter struct {
+	labels Labels
+	value  float64
+}
+
+// Prometheus metrics in Prometheus text exposition format. It is a http.Handler
+// that can be mounted on /metrics
+type Prometheus struct {
+	mu         sync.Mutex
+	buckets    []float64
+	counters   map[string]map[string]*counter
+	histograms map[string]map[string]*histogram
+}
+
+// NewPrometheus with buckets, or DefaultBuckets
+func NewPrometheus(buckets ...float64) *Prometheus {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	return &Prometheus{
+		buckets:    slices.Sorted(slices.Values(buckets)),
+		counters:   map[string]map[string]*counter{},
+		histograms: map[string]map[string]*histogram{},
+	}
+}
+
+func (this *Prometheus) Inc(name string, labels Labels) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	series, ok := this.counters[name]
+	if !ok {
+		series = map[string]*counter{}
+		this.counters[name] = series
+	}
+	key := seriesKey(name, labels)
+	c, ok := series[key]
+	if !ok {
+		c = &counter{labels: maps.Clone(labels)}
+		series[key] = c
+	}
+	c.value++
+}
+
+func (this *Prometheus) Observe(name string, value float64, labels Labels) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	series, ok := this.histograms[name]
+	if !ok {
+		series = map[string]*histogram{}
+		this.histograms[name] = series
+	}
+	key := seriesKey(name, labels)
+	h, ok := series[key]
+	if !ok {
+		h = &histogram{labels: maps.Clone(labels), counts: make([]uint64, len(this.buckets))}
+		series[key] = h
+	}
+	for i, bound := range this.buckets {
+		if value <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += value
+	h.count++
+}
+
+// WriteTo write metrics in text format, sorted by name and labels
+func (this *Prometheus) WriteTo(w io.Writer) (int64, error) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+
+	var buf bytes.Buffer
+
+	for _, name := range slices.Sorted(maps.Keys(this.counters)) {
+		fmt.Fprintf(&buf, "# TYPE %v counter\n", name)
+		series := this.counters[name]
+		for _, key := range slices.Sorted(maps.Keys(series)) {
+			fmt.Fprintf(&buf, "%v %v\n", key, formatFloat(series[key].value))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(this.histograms)) {
+		fmt.Fprintf(&buf, "# TYPE %v histogram\n", name)
+		series := this.histograms[name]
+		for _, key := range slices.Sorted(maps.Keys(series)) {
+			h := series[key]
+			for i, bound := range this.buckets {
+				fmt.Fprintf(&buf, "%v %v\n", bucketKey(name, h.labels, formatFloat(bound)), h.counts[i])
+			}
+			fmt.Fprintf(&buf, "%v %v\n", bucketKey(name, h.labels, "+Inf"), h.count)
+			fmt.Fprintf(&buf, "%v %v\n", seriesKey(name+"_sum", h.labels), formatFloat(h.sum))
+			fmt.Fprintf(&buf, "%v %v\n", seriesKey(name+"_count", h.labels), h.count)
+		}
+	}
+
+	return buf.WriteTo(w)
+}
+
+func (this *Prometheus) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = this.WriteTo(w)
+}
+
+func bucketKey(name string, labels Labels, le string) string {
+	withLe := maps.Clone(labels)
+	if withLe == nil {
+		withLe = Labels{}
+	}
+	withLe["le"] = le
+	return seriesKey(name+"_bucket", withLe)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
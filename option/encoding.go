@@ -0,0 +1,56 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// MarshalJSON encode None as null and Some(v) as v
+func (this Option[T]) MarshalJSON() ([]byte, error) {
+	if this.IsEmpty() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(this.Get())
+}
+
+// UnmarshalJSON decode null as None and other values as Some
+func (this *Option[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		this.value = _newNone[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	this.value = _newSome(value)
+	return nil
+}
+
+// IsZero report None, used by json omitzero tag
+func (this Option[T]) IsZero() bool {
+	return this.IsEmpty()
+}
+
+// Value write None as NULL and Some(v) as v
+func (this Option[T]) Value() (driver.Value, error) {
+	if this.IsEmpty() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(this.Get())
+}
+
+// Scan read NULL as None and other values as Some
+func (this *Option[T]) Scan(src any) error {
+	if src == nil {
+		this.value = _newNone[T]()
+		return nil
+	}
+	var value sql.Null[T]
+	if err := value.Scan(src); err != nil {
+		return err
+	}
+	this.value = _newSome(value.V)
+	return nil
+}
@@ -2,7 +2,10 @@ package option
 
 import (
 	"fmt"
+	"github.com/mobilemindtech/go-io/logging"
+	"github.com/mobilemindtech/go-io/tuple"
 	"github.com/mobilemindtech/go-io/util"
+	"iter"
 	"reflect"
 )
 
@@ -83,8 +86,16 @@ func None[T any]() *Option[T] {
 	return &Option[T]{value: _newNone[T]()}
 }
 
+// opt get option value. Nil and zero value options are None
+func (this *Option[T]) opt() _Option[T] {
+	if this == nil || this.value == nil {
+		return _newNone[T]()
+	}
+	return this.value
+}
+
 func (this *Option[T]) Get() T {
-	return this.value.get()
+	return this.opt().get()
 }
 
 func (this *Option[T]) OrNil() T {
@@ -152,23 +163,32 @@ func (this *Option[T]) Foreach(f func(T)) *Option[T] {
 
 func (this *Option[T]) Debug() {
 	typ := reflect.TypeOf(this)
-	fmt.Println(fmt.Sprintf("<DEBUG>: %v[value=%v]", typ, this.value.String()))
+	logging.Logger().Info("option", "type", typ.String(), "value", this.opt().String())
+}
+
+// All iterate option value, if some
+func (this *Option[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if this.NonEmpty() {
+			yield(this.Get())
+		}
+	}
 }
 
 func (this *Option[T]) IsSome() bool {
-	return this.value.isSome()
+	return this.opt().isSome()
 }
 
 func (this *Option[T]) IsNone() bool {
-	return this.value.isNone()
+	return this.opt().isNone()
 }
 
 func (this *Option[T]) Empty() bool {
-	return this.value.isNone()
+	return this.opt().isNone()
 }
 
 func (this *Option[T]) NonEmpty() bool {
-	return this.value.isSome()
+	return this.opt().isSome()
 }
 
 func (this *Option[T]) IsOption() bool {
@@ -185,7 +205,7 @@ func (this *Option[T]) IsEmpty() bool {
 
 func (this *Option[T]) String() string {
 	if this.IsSome() {
-		return this.value.String()
+		return this.opt().String()
 	} else {
 		return "None"
 	}
@@ -241,6 +261,14 @@ func OrElse[T any](v1 *Option[T], f func() *Option[T]) *Option[T] {
 	return f()
 }
 
+// Zip values of options as a tuple, if both are some
+func Zip[A, B any](a *Option[A], b *Option[B]) *Option[*tuple.T2[A, B]] {
+	if a.NonEmpty() && b.NonEmpty() {
+		return Some(tuple.Of2(a.Get(), b.Get()))
+	}
+	return None[*tuple.T2[A, B]]()
+}
+
 func MapMaybe[T any, R any](v1 T, f func(T) R) *Option[R] {
 	v := Of(v1)
 	if v.NonEmpty() {
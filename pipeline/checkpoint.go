@@ -0,0 +1,47 @@
+package pipeline
+
+import (
+	"github.com/mobilemindtech/go-io/checkpoint"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+)
+
+// WithCheckpoint save state after each completed step, so the run can be
+// resumed. The checkpoint is deleted when the pipeline completes
+func (this *Pipeline[T]) WithCheckpoint(checkpointer *checkpoint.Checkpointer) *Pipeline[T] {
+	this.checkpointer = checkpointer
+	return this
+}
+
+// WithRunID set run id of checkpoints. By default a new id is used on each run
+func (this *Pipeline[T]) WithRunID(runID string) *Pipeline[T] {
+	this.runID = runID
+	return this
+}
+
+// RunID checkpoints run id
+func (this *Pipeline[T]) RunID() string {
+	return this.runID
+}
+
+// Resume run from the last completed step of runID checkpoint, with the
+// saved state. Without checkpoint the pipeline runs from the first step
+func (this *Pipeline[T]) Resume(runID string) *result.Result[*option.Option[T]] {
+	this.runID = runID
+	if this.checkpointer == nil {
+		return this.run(0, nil)
+	}
+
+	res := this.checkpointer.Load(runID)
+	if res.IsError() {
+		this.computationResult = result.OfError[*option.Option[T]](res.Failure())
+		return this.computationResult
+	}
+	if res.Get().IsEmpty() {
+		return this.run(0, nil)
+	}
+
+	snapshot := res.Get().Get()
+	this.state = snapshot.State
+	return this.run(snapshot.Step, snapshot.Last.OrNil())
+}
@@ -0,0 +1,214 @@
+package pipeline
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/mobilemindtech/go-io/util"
+)
+
+// flowFunc computation that run sub pipelines. ok is false when no sub
+// pipeline runs, so the last result don't change
+type flowFunc func(r *runner, st *state.State, prefix stepPrefix) (value any, ok bool, h *halt)
+
+// Cases Switch sub pipelines by selector key
+type Cases map[any]IPipeline
+
+type defaultCase struct{}
+
+// Default Switch case key used when no other case match
+var Default = defaultCase{}
+
+// ResultTyper pipelines that know the result type. ForEach use it to build
+// a typed slice of results
+type ResultTyper interface {
+	ResultType() reflect.Type
+}
+
+// ResultType pipeline result type
+func (this *Pipeline[T]) ResultType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (this *Pipeline[T]) addFlow(f flowFunc) *Pipeline[T] {
+	this.computations = append(this.computations, &Computation{flow: f, varName: varName(this.state)})
+	return this
+}
+
+// When run sub pipeline on pipeline state when pred returns true. Pred args
+// are looked up by type, like Next
+func (this *Pipeline[T]) When(pred interface{}, pipe IPipeline) *Pipeline[T] {
+	predInfo := util.NewFuncInfo(pred)
+	return this.addFlow(func(r *runner, st *state.State, prefix stepPrefix) (any, bool, *halt) {
+		res := call(st, predInfo)
+		if len(res) != 1 || res[0].Kind() != reflect.Bool {
+			panic(fmt.Sprintf("When predicate should return bool, but return %v", predInfo.ReturnTypes))
+		}
+		if !res[0].Bool() {
+			return nil, false, nil
+		}
+		val, h := r.exec(st, pipe.GetComputations(), prefix, 0, nil, nil)
+		return val, h == nil, h
+	})
+}
+
+// Switch run the sub pipeline of the case returned by selector, or the
+// Default case. Nothing runs when no case match
+func (this *Pipeline[T]) Switch(selector interface{}, cases Cases) *Pipeline[T] {
+	selectorInfo := util.NewFuncInfo(selector)
+	return this.addFlow(func(r *runner, st *state.State, prefix stepPrefix) (any, bool, *halt) {
+		res := call(st, selectorInfo)
+		if len(res) != 1 {
+			panic(fmt.Sprintf("Switch selector should return one value, but return %v", len(res)))
+		}
+		pipe, ok := cases[res[0].Interface()]
+		if !ok {
+			pipe, ok = cases[Default]
+		}
+		if !ok {
+			return nil, false, nil
+		}
+		val, h := r.exec(st, pipe.GetComputations(), prefix, 0, nil, nil)
+		return val, h == nil, h
+	})
+}
+
+// Parallel run sub pipelines concurrently, each on a copy of pipeline state.
+// New and changed vars are merged into state in pipes order. The first error or None, in
+// pipes order, stop the pipeline
+func (this *Pipeline[T]) Parallel(pipes ...IPipeline) *Pipeline[T] {
+	return this.addFlow(func(r *runner, st *state.State, prefix stepPrefix) (any, bool, *halt) {
+		states := make([]*state.State, len(pipes))
+		values := make([]any, len(pipes))
+		halts := make([]*halt, len(pipes))
+
+		var wg sync.WaitGroup
+		for i, pipe := range pipes {
+			states[i] = st.Copy()
+			wg.Go(func() {
+				pr := &runner{name: r.name, debug: r.debug, logger: r.logger}
+				defer func() {
+					if rec := recover(); rec != nil {
+						halts[i] = &halt{err: pr.recovered(rec)}
+					}
+				}()
+				values[i], halts[i] = pr.exec(states[i], pipe.GetComputations(), prefix.step(i).sub(), 0, nil, nil)
+			})
+		}
+		wg.Wait()
+
+		for _, h := range halts {
+			if h != nil {
+				return nil, false, h
+			}
+		}
+
+		base := st.Copy()
+		for _, pst := range states {
+			merge(st, base, pst)
+		}
+		if len(values) == 0 {
+			return nil, false, nil
+		}
+		return values[len(values)-1], true, nil
+	})
+}
+
+// ForEach run sub pipeline for each item of the slice var, on a copy of
+// pipeline state with the item as itemVar. Generated vars of the item type are
+// not on the copy, so args looked up by type get the item. Use a key of itemVar
+// when there are named vars of the item type. Results are set as a slice var,
+// typed by the sub pipeline result type
+func (this *Pipeline[T]) ForEach(sliceVar string, itemVar string, pipe IPipeline) *Pipeline[T] {
+	return this.addFlow(func(r *runner, st *state.State, prefix stepPrefix) (any, bool, *halt) {
+		items, found := st.Items()[sliceVar]
+		if !found {
+			return nil, false, &halt{err: fmt.Errorf("var %v not found on state", sliceVar)}
+		}
+		slice := reflect.ValueOf(items)
+		if slice.Kind() != reflect.Slice {
+			return nil, false, &halt{err: fmt.Errorf("var %v type is %T, but expected a slice", sliceVar, items)}
+		}
+
+		elemType := reflect.TypeFor[any]()
+		if typer, ok := pipe.(ResultTyper); ok {
+			elemType = typer.ResultType()
+		}
+		results := reflect.MakeSlice(reflect.SliceOf(elemType), 0, slice.Len())
+
+		for i := 0; i < slice.Len(); i++ {
+			item := slice.Index(i).Interface()
+			itemState := st.Copy()
+			for key, val := range itemState.Items() {
+				if isGenerated(key) && reflect.TypeOf(val) == reflect.TypeOf(item) {
+					itemState.Delete(key)
+				}
+			}
+			itemState.SetVar(itemVar, item)
+			val, h := r.exec(itemState, pipe.GetComputations(), prefix.item(i), 0, nil, nil)
+			if h != nil {
+				return nil, false, h
+			}
+			itemResult := reflect.Zero(elemType)
+			if val != nil {
+				itemResult = reflect.ValueOf(val)
+				if !itemResult.Type().AssignableTo(elemType) {
+					return nil, false, &halt{err: fmt.Errorf(
+						"ForEach item result type is %T, but expected %v", val, elemType)}
+				}
+			}
+			results = reflect.Append(results, itemResult)
+		}
+
+		value := results.Interface()
+		st.SetVar(varName(st), value)
+		return value, true, nil
+	})
+}
+
+// Finally run f after pipeline, with the pipeline result. Run on errors,
+// None and panics
+func (this *Pipeline[T]) Finally(f func(*result.Result[*option.Option[T]])) *Pipeline[T] {
+	this.finalizers = append(this.finalizers, f)
+	return this
+}
+
+// merge set named vars of pst that are not on base or have other value, and
+// generated vars that are not on base. Generated var names are renamed to the
+// next st var name, in the order they were set
+func merge(st *state.State, base *state.State, pst *state.State) {
+	var generated []string
+	for key, val := range pst.Items() {
+		baseVal, exists := base.Items()[key]
+		switch {
+		case !isGenerated(key):
+			if !exists || !reflect.DeepEqual(baseVal, val) {
+				st.SetVar(key, val)
+			}
+		case !exists:
+			generated = append(generated, key)
+		}
+	}
+	slices.SortFunc(generated, func(a, b string) int {
+		return generatedIndex(a) - generatedIndex(b)
+	})
+	for _, key := range generated {
+		st.SetVar(varName(st), pst.Var(key))
+	}
+}
+
+func isGenerated(key string) bool {
+	return strings.HasPrefix(key, "__var__")
+}
+
+func generatedIndex(key string) int {
+	i, _ := strconv.Atoi(strings.TrimPrefix(key, "__var__"))
+	return i
+}
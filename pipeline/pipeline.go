@@ -3,15 +3,20 @@ package pipeline
 import (
 	"errors"
 	"fmt"
+	"github.com/mobilemindtech/go-io/checkpoint"
+	"github.com/mobilemindtech/go-io/logging"
+	"github.com/mobilemindtech/go-io/metrics"
 	"github.com/mobilemindtech/go-io/option"
 	"github.com/mobilemindtech/go-io/result"
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
 	"github.com/mobilemindtech/go-io/types/unit"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
+	"log/slog"
 	"reflect"
 	"runtime/debug"
+	"strconv"
+	"time"
 )
 
 type IPipeline interface {
@@ -23,12 +28,14 @@ type Computation struct {
 	varName  string
 	action   interface{}
 	funcInfo *util.FuncInfo
+	typed    *Step
+	flow     flowFunc
 }
 
 // Pipeline should return:
 // - any
 // - (any, error)
-// - (string, any) -> var name, type
+// - state.Tuples or a state.Tupler, like tuple.T2 -> many vars
 // - *result.Result[any]
 // - *option.Option[any]
 // - *result.Result[*option.Option[any]]
@@ -39,8 +46,15 @@ type Pipeline[T any] struct {
 	computations      []*Computation
 	computationResult *result.Result[*option.Option[T]]
 	debug             bool
+	name              string
+	logger            *slog.Logger
+	finalizers        []func(*result.Result[*option.Option[T]])
+	checkpointer      *checkpoint.Checkpointer
+	runID             string
 }
 
+const metricsRuntime = "pipeline"
+
 // New create new Pipeline
 func New[T any]() *Pipeline[T] {
 	return &Pipeline[T]{
@@ -50,6 +64,24 @@ func New[T any]() *Pipeline[T] {
 	}
 }
 
+// As name pipeline, used as metrics label
+func (this *Pipeline[T]) As(name string) *Pipeline[T] {
+	this.name = name
+	return this
+}
+
+// WithLogger set pipeline logger. By default the library logger is used
+func (this *Pipeline[T]) WithLogger(logger *slog.Logger) *Pipeline[T] {
+	this.logger = logger
+	return this
+}
+
+// Debug log each step
+func (this *Pipeline[T]) Debug() *Pipeline[T] {
+	this.debug = true
+	return this
+}
+
 func (this *Pipeline[T]) GetComputations() []*Computation {
 	return this.computations
 }
@@ -58,14 +90,9 @@ func (this *Pipeline[T]) UnsafeRunPipeline() types.ResultOptionAny {
 	return this.UnsafeRun().ToResultOfOption()
 }
 
-func (this *Pipeline[T]) getVarName() string {
-	return fmt.Sprintf("__var__%v", this.state.Count())
-}
-
 func (this *Pipeline[T]) addComputation(f interface{}) *Pipeline[T] {
-	varName := this.getVarName()
 	funcInfo := util.NewFuncInfo(f)
-	this.computations = append(this.computations, &Computation{action: f, funcInfo: funcInfo, varName: varName})
+	this.computations = append(this.computations, &Computation{action: f, funcInfo: funcInfo, varName: varName(this.state)})
 	return this
 }
 
@@ -87,6 +114,12 @@ func (this *Pipeline[T]) Yield() *option.Option[T] {
 	return this.computationResult.Get()
 }
 
+// Step add typed step as computation. The step reads and writes the pipeline state
+func (this *Pipeline[T]) Step(step *Step) *Pipeline[T] {
+	this.computations = append(this.computations, &Computation{typed: step, varName: step.Writes()})
+	return this
+}
+
 // Next Pipeline computation
 func (this *Pipeline[T]) Next(f interface{}) *Pipeline[T] {
 	return this.addComputation(f)
@@ -94,153 +127,316 @@ func (this *Pipeline[T]) Next(f interface{}) *Pipeline[T] {
 
 // UnsafeRun Run Pipeline
 func (this *Pipeline[T]) UnsafeRun() (value *result.Result[*option.Option[T]]) {
+	if this.checkpointer != nil && this.runID == "" {
+		this.runID = logging.NewRunId()
+	}
+	return this.run(0, nil)
+}
 
-	currStackPointer := -1
+// run computations from a step. Each completed step is saved on checkpointer
+func (this *Pipeline[T]) run(from int, lastResult any) (value *result.Result[*option.Option[T]]) {
+
+	start := time.Now()
+	runID := this.runID
+	if runID == "" {
+		runID = logging.NewRunId()
+	}
+	r := &runner{
+		name:   this.name,
+		debug:  this.debug,
+		logger: logging.Or(this.logger).With(logging.KeyIO, this.name, logging.KeyRunId, runID),
+	}
 
 	defer func() {
-		if r := recover(); r != nil {
-			msg := fmt.Sprintf(
-				"Pipeline error on StackPointer %v. Message %v. StackTrace: %v",
-				currStackPointer, r, string(debug.Stack()))
+		metrics.ObserveRun(metricsRuntime, this.name, start,
+			value.FailureOrNil(), value.IsOk() && value.Get().IsEmpty())
+	}()
 
-			fmt.Println(fmt.Sprintf("<Pipeline recover> %v", msg))
-			value = result.OfError[*option.Option[T]](errors.New(msg))
+	defer func() {
+		for _, f := range this.finalizers {
+			f(value)
+		}
+	}()
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			value = result.OfError[*option.Option[T]](r.recovered(rec))
 			this.computationResult = value
 		}
 	}()
 
-	var lastResult interface{}
+	var onStep stepHook
+	if this.checkpointer != nil {
+		onStep = func(step int, lastResult any) error {
+			return this.checkpointer.Save(this.runID, step, this.state, lastResult)
+		}
+	}
 
-	for i, step := range this.computations {
+	lastResult, h := r.exec(this.state, this.computations, stepPrefix{}, from, lastResult, onStep)
 
-		stateCopy := this.state.Copy()
-		currStackPointer = i
-		nextFnInfo := step.funcInfo
-		currStateSize := this.state.Count()
-		var fnParams []reflect.Value
-		var fnResults []interface{}
-		var fnResultTypes []reflect.Type
-		var isErrorFunc bool
+	if this.checkpointer != nil && (h == nil || h.err == nil) {
+		if err := this.checkpointer.Delete(this.runID); err != nil {
+			r.logger.Warn("checkpoint delete failed", logging.KeyError, err)
+		}
+	}
 
-		handleResult := func(res []reflect.Value) {
-			if len(res) > 2 {
-				panic(fmt.Sprintf("return type count should be < 3, but is %v", len(res)))
-			}
-			for i := 0; i < len(res); i++ {
-				fnResults = append(fnResults, res[i].Interface())
-				fnResultTypes = append(fnResultTypes, res[i].Type())
-			}
+	if h != nil {
+		if h.err != nil {
+			value = result.OfError[*option.Option[T]](h.err)
+		} else {
+			value = result.OfValue(option.None[T]())
+		}
+		this.computationResult = value
+		return
+	}
 
-			if len(fnResultTypes) == 2 {
-				firstType := fnResultTypes[0]
-				secondType := fnResultTypes[1]
+	if reflect.TypeFor[T]() == reflect.TypeFor[*unit.Unit]() {
+		var unit interface{} = unit.OfUnit()
+		value = result.OfValue(option.Some(unit.(T)))
+		this.computationResult = value
 
-				isErrorFunc = secondType.Implements(reflect.TypeFor[error]())
+	} else {
+		r := result.Cast[T](lastResult)
+		value = result.OfValue(option.Some(r.Get()))
+		this.computationResult = value
+	}
+	return
+}
 
-				if !isErrorFunc {
-					panic(fmt.Sprintf("func should be return (any, error), but return (%v, %v)",
-						firstType.String(), secondType.String()))
-				}
-			} else if len(fnResultTypes) > 2 {
-				panic(fmt.Sprintf("return type count should be < 3, but is %v",
-					len(fnResultTypes)))
+// halt stop pipeline with an error, or with None when err is nil
+type halt struct {
+	err error
+}
+
+// runner run computations on a state. Sub pipelines of When, Switch,
+// Parallel and ForEach run with the same runner
+type runner struct {
+	name   string
+	debug  bool
+	logger *slog.Logger
+	step   string
+}
+
+func varName(st *state.State) string {
+	return fmt.Sprintf("__var__%v", st.Count())
+}
+
+func (this *runner) recovered(rec any) error {
+	metrics.IncPanic(metricsRuntime, this.name)
+	msg := fmt.Sprintf(
+		"Pipeline error on StackPointer %v. Message %v. StackTrace: %v",
+		this.step, rec, string(debug.Stack()))
+
+	logger := this.logger
+	if this.debug {
+		logger = logger.With(logging.KeyStack, string(debug.Stack()))
+	}
+	logger.Debug("recover", "step", this.step, logging.KeyError, rec)
+	return errors.New(msg)
+}
+
+// call func with args looked up by type on a copy of state
+func call(st *state.State, fnInfo *util.FuncInfo) []reflect.Value {
+	if st.Count() < fnInfo.ArgsCount {
+		panic(fmt.Sprintf(
+			"expected %v args, but have %v state results", fnInfo.ArgsCount, st.Count()))
+	}
+	stateCopy := st.Copy()
+	var fnParams []reflect.Value
+	for j := 0; j < fnInfo.ArgsCount; j++ {
+		_, val := state.LookupVar(stateCopy, fnInfo.ArgType(j), true)
+		fnParams = append(fnParams, val)
+	}
+	return fnInfo.Call(fnParams)
+}
+
+// setVar set step result as state var. Tuples are set as many vars, values
+// without name get a generated var name
+func setVar(st *state.State, name string, value interface{}) {
+	var tuples state.Tuples
+	switch v := value.(type) {
+	case state.Tuples:
+		tuples = v
+	case state.Tupler:
+		tuples = state.Named(v)
+	default:
+		st.SetVar(name, value)
+		return
+	}
+	for i, tp := range tuples {
+		key := tp.Key
+		if key == "" {
+			if i == 0 {
+				key = name
+			} else {
+				key = varName(st)
 			}
 		}
+		st.SetVar(key, tp.Val)
+	}
+}
 
-		if currStateSize < nextFnInfo.ArgsCount {
-			panic(fmt.Sprintf(
-				"expected %v args, but have %v state results", nextFnInfo.ArgsCount, currStateSize))
-		}
+// stepHook called after each completed step with the count of completed
+// steps and the last result
+type stepHook func(step int, lastResult any) error
 
-		if this.debug {
-			log.Print("step %v, args %v", i, nextFnInfo.ArgsCount)
+// stepPrefix prefix of the step labels of a sub pipeline. log has the ForEach
+// item indexes, like 2.5.1, metric is the position without them, like 2.1, so
+// metrics labels are bounded
+type stepPrefix struct {
+	log    string
+	metric string
+}
+
+// step label of step i
+func (this stepPrefix) step(i int) stepPrefix {
+	return stepPrefix{log: this.log + strconv.Itoa(i), metric: this.metric + strconv.Itoa(i)}
+}
+
+// sub prefix of a sub pipeline of this step
+func (this stepPrefix) sub() stepPrefix {
+	return stepPrefix{log: this.log + ".", metric: this.metric + "."}
+}
+
+// item prefix of a ForEach item. The index is only in log label
+func (this stepPrefix) item(i int) stepPrefix {
+	return stepPrefix{log: this.log + strconv.Itoa(i) + ".", metric: this.metric}
+}
+
+// exec run computations on state, from a step, and return the last result.
+// Steps are labeled by prefix and index, like 2.1 for the second step of a
+// sub pipeline
+func (this *runner) exec(st *state.State, computations []*Computation, prefix stepPrefix, from int, lastResult any, onStep stepHook) (any, *halt) {
+
+	for i := from; i < len(computations); i++ {
+		label := prefix.step(i)
+		this.step = label.log
+		stepStart := time.Now()
+
+		val, ok, h := this.execStep(st, computations[i], label)
+		metrics.ObserveStep(metricsRuntime, this.name, label.metric, stepStart)
+		if h != nil {
+			return nil, h
+		}
+		if ok {
+			lastResult = val
 		}
+		if onStep != nil {
+			if err := onStep(i+1, lastResult); err != nil {
+				return nil, &halt{err: err}
+			}
+		}
+	}
+	return lastResult, nil
+}
+
+// execStep run one computation. ok is false when the step has no result
+func (this *runner) execStep(st *state.State, step *Computation, label stepPrefix) (lastResult interface{}, ok bool, h *halt) {
 
-		for j := 0; j < nextFnInfo.ArgsCount; j++ {
-			_, val := state.LookupVar(stateCopy, nextFnInfo.ArgType(j), true)
-			fnParams = append(fnParams, val)
+	if step.typed != nil {
+		val, err := step.typed.run(st)
+		if err != nil {
+			return nil, false, &halt{err: err}
 		}
+		return val, true, nil
+	}
 
-		handleResult(nextFnInfo.Call(fnParams))
+	if step.flow != nil {
+		return step.flow(this, st, label.sub())
+	}
 
-		if len(fnResults) == 0 {
-			// ignore result
-			continue
+	nextFnInfo := step.funcInfo
+	var fnResults []interface{}
+	var fnResultTypes []reflect.Type
+	var isErrorFunc bool
+
+	handleResult := func(res []reflect.Value) {
+		if len(res) > 2 {
+			panic(fmt.Sprintf("return type count should be < 3, but is %v", len(res)))
+		}
+		for i := 0; i < len(res); i++ {
+			fnResults = append(fnResults, res[i].Interface())
+			fnResultTypes = append(fnResultTypes, res[i].Type())
 		}
 
-		var fnResult interface{}
-		varName := this.getVarName()
-		var errrorResult error
+		if len(fnResultTypes) == 2 {
+			firstType := fnResultTypes[0]
+			secondType := fnResultTypes[1]
 
-		switch len(fnResults) {
-		case 1:
-			fnResult = fnResults[0]
-			break
-		default:
+			isErrorFunc = secondType.Implements(reflect.TypeFor[error]())
 
-			if util.IsNotNil(fnResults[1]) {
-				errrorResult = fnResults[1].(error)
+			if !isErrorFunc {
+				panic(fmt.Sprintf("func should be return (any, error), but return (%v, %v)",
+					firstType.String(), secondType.String()))
 			}
-			fnResult = fnResults[0]
-
+		} else if len(fnResultTypes) > 2 {
+			panic(fmt.Sprintf("return type count should be < 3, but is %v",
+				len(fnResultTypes)))
 		}
+	}
 
-		if isErrorFunc && util.IsNotNil(errrorResult) {
-			value = result.OfError[*option.Option[T]](errrorResult)
-			this.computationResult = value
-			return
+	if this.debug {
+		this.logger.Info("step", "step", label.log, "args", nextFnInfo.ArgsCount)
+	}
+
+	handleResult(call(st, nextFnInfo))
+
+	if len(fnResults) == 0 {
+		// ignore result
+		return nil, false, nil
+	}
+
+	var fnResult interface{}
+	name := varName(st)
+	var errrorResult error
+
+	switch len(fnResults) {
+	case 1:
+		fnResult = fnResults[0]
+		break
+	default:
+
+		if util.IsNotNil(fnResults[1]) {
+			errrorResult = fnResults[1].(error)
 		}
+		fnResult = fnResults[0]
 
-		if rs, ok := fnResult.(result.IResult); ok {
-			if rs.HasError() {
-				value = result.OfError[*option.Option[T]](rs.GetError())
-				this.computationResult = value
-				return
-			} else {
-				lastResult = rs.GetValue()
-				if util.IsNotNil(lastResult) {
-
-					if opt, ok := lastResult.(option.IOption); ok {
-						if opt.IsEmpty() {
-							value = result.OfValue[*option.Option[T]](option.None[T]())
-							this.computationResult = value
-							return
-						} else {
-							this.state.SetVar(varName, opt.GetValue())
-						}
+	}
+
+	if isErrorFunc && util.IsNotNil(errrorResult) {
+		return nil, false, &halt{err: errrorResult}
+	}
+
+	if rs, ok := fnResult.(result.IResult); ok {
+		if rs.HasError() {
+			return nil, false, &halt{err: rs.GetError()}
+		} else {
+			lastResult = rs.GetValue()
+			if util.IsNotNil(lastResult) {
+
+				if opt, ok := lastResult.(option.IOption); ok {
+					if opt.IsEmpty() {
+						return nil, false, &halt{}
 					} else {
-						this.state.SetVar(varName, lastResult)
+						setVar(st, name, opt.GetValue())
 					}
 				} else {
-					value = result.OfValue[*option.Option[T]](option.None[T]())
-					this.computationResult = value
-					return
+					setVar(st, name, lastResult)
 				}
-			}
-		} else if opt, ok := fnResult.(option.IOption); ok {
-			if opt.IsEmpty() {
-				value = result.OfValue[*option.Option[T]](option.None[T]())
-				this.computationResult = value
-				return
 			} else {
-				lastResult = opt.GetValue()
-				this.state.SetVar(varName, lastResult)
+				return nil, false, &halt{}
 			}
+		}
+	} else if opt, ok := fnResult.(option.IOption); ok {
+		if opt.IsEmpty() {
+			return nil, false, &halt{}
 		} else {
-			lastResult = fnResult
-			this.state.SetVar(varName, fnResult)
+			lastResult = opt.GetValue()
+			setVar(st, name, lastResult)
 		}
-	}
-
-	if reflect.TypeFor[T]() == reflect.TypeFor[*unit.Unit]() {
-		var unit interface{} = unit.OfUnit()
-		value = result.OfValue(option.Some(unit.(T)))
-		this.computationResult = value
-
 	} else {
-		r := result.Cast[T](lastResult)
-		value = result.OfValue(option.Some(r.Get()))
-		this.computationResult = value
+		lastResult = fnResult
+		setVar(st, name, fnResult)
 	}
-	return
+	return lastResult, true, nil
 }
@@ -0,0 +1,315 @@
+// Code generated by go-io/internal/gen. DO NOT EDIT.
+
+package pipeline
+
+import "github.com/mobilemindtech/go-io/state"
+
+// Step1 step of 1 input
+func Step1[A, T any](inA state.Key[A], out state.Key[T], fn func(A) (T, error)) *Step {
+	reads := []string{inA.String()}
+	return newStep(out, reads, func(st *state.State) (T, error) {
+		var zero T
+		a, err := inA.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		return fn(a)
+	})
+}
+
+// Step2 step of 2 inputs
+func Step2[A, B, T any](inA state.Key[A], inB state.Key[B], out state.Key[T], fn func(A, B) (T, error)) *Step {
+	reads := []string{inA.String(), inB.String()}
+	return newStep(out, reads, func(st *state.State) (T, error) {
+		var zero T
+		a, err := inA.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		b, err := inB.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		return fn(a, b)
+	})
+}
+
+// Step3 step of 3 inputs
+func Step3[A, B, C, T any](inA state.Key[A], inB state.Key[B], inC state.Key[C], out state.Key[T], fn func(A, B, C) (T, error)) *Step {
+	reads := []string{inA.String(), inB.String(), inC.String()}
+	return newStep(out, reads, func(st *state.State) (T, error) {
+		var zero T
+		a, err := inA.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		b, err := inB.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		c, err := inC.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		return fn(a, b, c)
+	})
+}
+
+// Step4 step of 4 inputs
+func Step4[A, B, C, D, T any](inA state.Key[A], inB state.Key[B], inC state.Key[C], inD state.Key[D], out state.Key[T], fn func(A, B, C, D) (T, error)) *Step {
+	reads := []string{inA.String(), inB.String(), inC.String(), inD.String()}
+	return newStep(out, reads, func(st *state.State) (T, error) {
+		var zero T
+		a, err := inA.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		b, err := inB.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		c, err := inC.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		d, err := inD.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		return fn(a, b, c, d)
+	})
+}
+
+// Step5 step of 5 inputs
+func Step5[A, B, C, D, E, T any](inA state.Key[A], inB state.Key[B], inC state.Key[C], inD state.Key[D], inE state.Key[E], out state.Key[T], fn func(A, B, C, D, E) (T, error)) *Step {
+	reads := []string{inA.String(), inB.String(), inC.String(), inD.String(), inE.String()}
+	return newStep(out, reads, func(st *state.State) (T, error) {
+		var zero T
+		a, err := inA.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		b, err := inB.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		c, err := inC.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		d, err := inD.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		e, err := inE.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		return fn(a, b, c, d, e)
+	})
+}
+
+// Step6 step of 6 inputs
+func Step6[A, B, C, D, E, F, T any](inA state.Key[A], inB state.Key[B], inC state.Key[C], inD state.Key[D], inE state.Key[E], inF state.Key[F], out state.Key[T], fn func(A, B, C, D, E, F) (T, error)) *Step {
+	reads := []string{inA.String(), inB.String(), inC.String(), inD.String(), inE.String(), inF.String()}
+	return newStep(out, reads, func(st *state.State) (T, error) {
+		var zero T
+		a, err := inA.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		b, err := inB.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		c, err := inC.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		d, err := inD.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		e, err := inE.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		f, err := inF.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		return fn(a, b, c, d, e, f)
+	})
+}
+
+// Step7 step of 7 inputs
+func Step7[A, B, C, D, E, F, G, T any](inA state.Key[A], inB state.Key[B], inC state.Key[C], inD state.Key[D], inE state.Key[E], inF state.Key[F], inG state.Key[G], out state.Key[T], fn func(A, B, C, D, E, F, G) (T, error)) *Step {
+	reads := []string{inA.String(), inB.String(), inC.String(), inD.String(), inE.String(), inF.String(), inG.String()}
+	return newStep(out, reads, func(st *state.State) (T, error) {
+		var zero T
+		a, err := inA.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		b, err := inB.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		c, err := inC.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		d, err := inD.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		e, err := inE.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		f, err := inF.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		g, err := inG.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		return fn(a, b, c, d, e, f, g)
+	})
+}
+
+// Step8 step of 8 inputs
+func Step8[A, B, C, D, E, F, G, H, T any](inA state.Key[A], inB state.Key[B], inC state.Key[C], inD state.Key[D], inE state.Key[E], inF state.Key[F], inG state.Key[G], inH state.Key[H], out state.Key[T], fn func(A, B, C, D, E, F, G, H) (T, error)) *Step {
+	reads := []string{inA.String(), inB.String(), inC.String(), inD.String(), inE.String(), inF.String(), inG.String(), inH.String()}
+	return newStep(out, reads, func(st *state.State) (T, error) {
+		var zero T
+		a, err := inA.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		b, err := inB.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		c, err := inC.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		d, err := inD.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		e, err := inE.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		f, err := inF.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		g, err := inG.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		h, err := inH.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		return fn(a, b, c, d, e, f, g, h)
+	})
+}
+
+// Step9 step of 9 inputs
+func Step9[A, B, C, D, E, F, G, H, I, T any](inA state.Key[A], inB state.Key[B], inC state.Key[C], inD state.Key[D], inE state.Key[E], inF state.Key[F], inG state.Key[G], inH state.Key[H], inI state.Key[I], out state.Key[T], fn func(A, B, C, D, E, F, G, H, I) (T, error)) *Step {
+	reads := []string{inA.String(), inB.String(), inC.String(), inD.String(), inE.String(), inF.String(), inG.String(), inH.String(), inI.String()}
+	return newStep(out, reads, func(st *state.State) (T, error) {
+		var zero T
+		a, err := inA.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		b, err := inB.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		c, err := inC.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		d, err := inD.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		e, err := inE.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		f, err := inF.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		g, err := inG.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		h, err := inH.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		i, err := inI.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		return fn(a, b, c, d, e, f, g, h, i)
+	})
+}
+
+// Step10 step of 10 inputs
+func Step10[A, B, C, D, E, F, G, H, I, J, T any](inA state.Key[A], inB state.Key[B], inC state.Key[C], inD state.Key[D], inE state.Key[E], inF state.Key[F], inG state.Key[G], inH state.Key[H], inI state.Key[I], inJ state.Key[J], out state.Key[T], fn func(A, B, C, D, E, F, G, H, I, J) (T, error)) *Step {
+	reads := []string{inA.String(), inB.String(), inC.String(), inD.String(), inE.String(), inF.String(), inG.String(), inH.String(), inI.String(), inJ.String()}
+	return newStep(out, reads, func(st *state.State) (T, error) {
+		var zero T
+		a, err := inA.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		b, err := inB.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		c, err := inC.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		d, err := inD.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		e, err := inE.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		f, err := inF.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		g, err := inG.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		h, err := inH.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		i, err := inI.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		j, err := inJ.Get(st)
+		if err != nil {
+			return zero, err
+		}
+		return fn(a, b, c, d, e, f, g, h, i, j)
+	})
+}
@@ -0,0 +1,167 @@
+package pipeline
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"github.com/mobilemindtech/go-io/logging"
+	"github.com/mobilemindtech/go-io/metrics"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/mobilemindtech/go-io/types"
+)
+
+// Step typed pipeline step, built by Step0..Step10. Inputs and output are
+// state vars of state.Key, so arity and types are checked by the compiler
+type Step struct {
+	reads  []string
+	writes string
+	run    func(*state.State) (any, error)
+}
+
+func newStep[T any](out state.Key[T], reads []string, f func(*state.State) (T, error)) *Step {
+	return &Step{
+		reads:  reads,
+		writes: out.String(),
+		run: func(st *state.State) (any, error) {
+			val, err := f(st)
+			if err != nil {
+				return nil, err
+			}
+			out.Set(st, val)
+			return val, nil
+		},
+	}
+}
+
+// Reads input var names
+func (this *Step) Reads() []string {
+	return this.reads
+}
+
+// Writes output var name
+func (this *Step) Writes() string {
+	return this.writes
+}
+
+func (this *Step) String() string {
+	return fmt.Sprintf("Step(%v => %v)", this.reads, this.writes)
+}
+
+// Step0 step without inputs
+func Step0[T any](out state.Key[T], fn func() (T, error)) *Step {
+	return newStep(out, nil, func(_ *state.State) (T, error) {
+		return fn()
+	})
+}
+
+// Typed pipeline of typed steps. The result is the out var after the last step
+type Typed[T any] struct {
+	steps  []*Step
+	out    state.Key[T]
+	state  *state.State
+	name   string
+	debug  bool
+	logger *slog.Logger
+}
+
+func NewTyped[T any](out state.Key[T]) *Typed[T] {
+	return &Typed[T]{out: out, state: state.NewState()}
+}
+
+// As name pipeline, used as metrics label
+func (this *Typed[T]) As(name string) *Typed[T] {
+	this.name = name
+	return this
+}
+
+// Debug log the stack trace of recovered panics
+func (this *Typed[T]) Debug() *Typed[T] {
+	this.debug = true
+	return this
+}
+
+// WithLogger set pipeline logger. By default the library logger is used
+func (this *Typed[T]) WithLogger(logger *slog.Logger) *Typed[T] {
+	this.logger = logger
+	return this
+}
+
+// WithState run steps on state, to read vars of resources or other pipelines
+func (this *Typed[T]) WithState(st *state.State) *Typed[T] {
+	this.state = st
+	return this
+}
+
+// Then add steps
+func (this *Typed[T]) Then(steps ...*Step) *Typed[T] {
+	this.steps = append(this.steps, steps...)
+	return this
+}
+
+// ResultType pipeline result type
+func (this *Typed[T]) ResultType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (this *Typed[T]) Steps() []*Step {
+	return this.steps
+}
+
+// GetComputations steps as computations, so a Pipeline can add typed steps by Suspension
+func (this *Typed[T]) GetComputations() []*Computation {
+	computations := make([]*Computation, len(this.steps))
+	for i, step := range this.steps {
+		computations[i] = &Computation{typed: step, varName: step.Writes()}
+	}
+	return computations
+}
+
+func (this *Typed[T]) UnsafeRunPipeline() types.ResultOptionAny {
+	return this.UnsafeRun().ToResultOfOption()
+}
+
+// UnsafeRun run steps in order. Step errors and panics stop the pipeline
+func (this *Typed[T]) UnsafeRun() (value *result.Result[*option.Option[T]]) {
+	start := time.Now()
+	current := -1
+
+	defer func() {
+		metrics.ObserveRun(metricsRuntime, this.name, start,
+			value.FailureOrNil(), value.IsOk() && value.Get().IsEmpty())
+	}()
+
+	defer func() {
+		if r := recover(); r != nil {
+			metrics.IncPanic(metricsRuntime, this.name)
+			logger := logging.Or(this.logger)
+			if this.debug {
+				logger = logger.With(logging.KeyStack, string(debug.Stack()))
+			}
+			logger.Debug("recover", logging.KeyIO, this.name, "step", current, logging.KeyError, r)
+			value = result.OfError[*option.Option[T]](
+				fmt.Errorf("pipeline error on step %v: %v", current, r))
+		}
+	}()
+
+	for i, step := range this.steps {
+		current = i
+		stepStart := time.Now()
+		_, err := step.run(this.state)
+		metrics.ObserveStep(metricsRuntime, this.name, strconv.Itoa(i), stepStart)
+		if err != nil {
+			return result.OfError[*option.Option[T]](err)
+		}
+	}
+
+	val, err := this.out.Get(this.state)
+	if err != nil {
+		return result.OfValue(option.None[T]())
+	}
+	return result.OfValue(option.Of(val))
+}
@@ -0,0 +1,235 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrLimitExceeded is returned when a leaky bucket queue is full
+var ErrLimitExceeded = errors.New("rate limit exceeded")
+
+// Limiter limit operations by key, like a host. Use empty key for a global limit
+type Limiter interface {
+	// Wait block until operation of key is allowed or context is done
+	Wait(ctx context.Context, key string) error
+	// Allow consume a slot of key without wait
+	Allow(key string) bool
+	// Pause block key for duration, like a server Retry-After
+	Pause(key string, d time.Duration)
+}
+
+type bucket struct {
+	tokens      float64
+	last        time.Time
+	next        time.Time
+	pausedUntil time.Time
+}
+
+// sweepInterval is the min interval between removals of idle buckets
+const sweepInterval = time.Minute
+
+type limiter struct {
+	buckets map[string]*bucket
+	// idle check if bucket is in the state of a new bucket, so it can be removed
+	idle      func(b *bucket, now time.Time) bool
+	lastSweep time.Time
+	mu        sync.Mutex
+}
+
+func (this *limiter) bucket(key string, init func() *bucket) *bucket {
+	this.sweep(time.Now())
+	b, ok := this.buckets[key]
+	if !ok {
+		b = init()
+		this.buckets[key] = b
+	}
+	return b
+}
+
+// sweep remove idle buckets, so keys that are not used anymore don't grow the map
+func (this *limiter) sweep(now time.Time) {
+	if now.Sub(this.lastSweep) < sweepInterval {
+		return
+	}
+	this.lastSweep = now
+	for key, b := range this.buckets {
+		if this.idle(b, now) {
+			delete(this.buckets, key)
+		}
+	}
+}
+
+func (this *limiter) pause(key string, d time.Duration, init func() *bucket) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	b := this.bucket(key, init)
+	until := time.Now().Add(d)
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
+
+// TokenBucket allow bursts of burst operations, refilled at rate per second
+type TokenBucket struct {
+	limiter
+	rate  float64
+	burst int
+}
+
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if rate <= 0 || burst <= 0 {
+		panic("rate and burst should be > 0")
+	}
+	tb := &TokenBucket{
+		limiter: limiter{buckets: map[string]*bucket{}, lastSweep: time.Now()},
+		rate:    rate,
+		burst:   burst,
+	}
+	tb.idle = tb.isIdle
+	return tb
+}
+
+// isIdle check if bucket is full and not paused
+func (this *TokenBucket) isIdle(b *bucket, now time.Time) bool {
+	tokens := b.tokens + now.Sub(b.last).Seconds()*this.rate
+	return tokens >= float64(this.burst) && !now.Before(b.pausedUntil)
+}
+
+func (this *TokenBucket) newBucket() *bucket {
+	return &bucket{tokens: float64(this.burst), last: time.Now()}
+}
+
+// refill add tokens since last call
+func (this *TokenBucket) refill(b *bucket, now time.Time) {
+	if !now.After(b.last) {
+		return
+	}
+	elapsed := now.Sub(b.last).Seconds()
+	b.tokens = math.Min(float64(this.burst), b.tokens+elapsed*this.rate)
+	b.last = now
+}
+
+func (this *TokenBucket) Allow(key string) bool {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	now := time.Now()
+	b := this.bucket(key, this.newBucket)
+	this.refill(b, now)
+	if now.Before(b.pausedUntil) || b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (this *TokenBucket) Wait(ctx context.Context, key string) error {
+	this.mu.Lock()
+	now := time.Now()
+	b := this.bucket(key, this.newBucket)
+	this.refill(b, now)
+	b.tokens--
+	delay := time.Duration(0)
+	if paused := b.pausedUntil.Sub(now); paused > 0 {
+		delay = paused
+	}
+	if b.tokens < 0 {
+		delay += time.Duration(-b.tokens / this.rate * float64(time.Second))
+	}
+	this.mu.Unlock()
+
+	if err := sleep(ctx, delay); err != nil {
+		this.mu.Lock()
+		b.tokens++
+		this.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (this *TokenBucket) Pause(key string, d time.Duration) {
+	this.pause(key, d, this.newBucket)
+}
+
+// LeakyBucket space operations evenly at rate per second, queueing up to capacity operations
+type LeakyBucket struct {
+	limiter
+	interval time.Duration
+	capacity int
+}
+
+func NewLeakyBucket(rate float64, capacity int) *LeakyBucket {
+	if rate <= 0 || capacity < 0 {
+		panic("rate should be > 0 and capacity >= 0")
+	}
+	lb := &LeakyBucket{
+		limiter:  limiter{buckets: map[string]*bucket{}, lastSweep: time.Now()},
+		interval: time.Duration(float64(time.Second) / rate),
+		capacity: capacity,
+	}
+	lb.idle = lb.isIdle
+	return lb
+}
+
+// isIdle check if bucket has no scheduled operation and is not paused
+func (this *LeakyBucket) isIdle(b *bucket, now time.Time) bool {
+	return !b.next.After(now) && !b.pausedUntil.After(now)
+}
+
+func (this *LeakyBucket) newBucket() *bucket {
+	return &bucket{}
+}
+
+// reserve schedule next operation of key and return delay until it
+func (this *LeakyBucket) reserve(key string, maxDelay time.Duration) (time.Duration, bool) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	now := time.Now()
+	b := this.bucket(key, this.newBucket)
+	at := now
+	if b.next.After(at) {
+		at = b.next
+	}
+	if b.pausedUntil.After(at) {
+		at = b.pausedUntil
+	}
+	delay := at.Sub(now)
+	if delay > maxDelay {
+		return delay, false
+	}
+	b.next = at.Add(this.interval)
+	return delay, true
+}
+
+func (this *LeakyBucket) Allow(key string) bool {
+	_, ok := this.reserve(key, 0)
+	return ok
+}
+
+func (this *LeakyBucket) Wait(ctx context.Context, key string) error {
+	delay, ok := this.reserve(key, time.Duration(this.capacity)*this.interval)
+	if !ok {
+		return ErrLimitExceeded
+	}
+	return sleep(ctx, delay)
+}
+
+func (this *LeakyBucket) Pause(key string, d time.Duration) {
+	this.pause(key, d, this.newBucket)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
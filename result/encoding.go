@@ -0,0 +1,51 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// resultJSON is the JSON shape of Result: {"ok":true,"value":v} or {"ok":false,"error":"message"}
+type resultJSON struct {
+	Ok    bool            `json:"ok"`
+	Value json.RawMessage `json:"value,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+func (this Result[T]) MarshalJSON() ([]byte, error) {
+	if this.IsError() {
+		return json.Marshal(&resultJSON{Ok: false, Error: this.Failure().Error()})
+	}
+	value, err := json.Marshal(this.Get())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&resultJSON{Ok: true, Value: value})
+}
+
+// UnmarshalJSON decode Result. The ok field is required and errors are decoded with errors.New
+func (this *Result[T]) UnmarshalJSON(data []byte) error {
+	var shape struct {
+		resultJSON
+		Ok *bool `json:"ok"`
+	}
+	if err := json.Unmarshal(data, &shape); err != nil {
+		return err
+	}
+	if shape.Ok == nil {
+		return fmt.Errorf("result should have ok field: %v", string(data))
+	}
+	if !*shape.Ok {
+		*this = *OfError[T](errors.New(shape.Error))
+		return nil
+	}
+	var value T
+	if len(shape.Value) > 0 {
+		if err := json.Unmarshal(shape.Value, &value); err != nil {
+			return err
+		}
+	}
+	*this = *OfValue(value)
+	return nil
+}
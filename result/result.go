@@ -2,10 +2,12 @@ package result
 
 import (
 	"fmt"
+	"iter"
 	"reflect"
 	_ "log"
 	"github.com/mobilemindtech/go-io/fault"
 	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/tuple"
 	"github.com/mobilemindtech/go-io/types/unit"
 	"github.com/mobilemindtech/go-io/util"
 )
@@ -278,6 +280,15 @@ func (this *Result[T]) ToResultOfOption() *Result[*option.Option[any]] {
 	panic("Invalid empty result")
 }
 
+// All iterate result value, if ok
+func (this *Result[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if this.IsOk() {
+			yield(this.Get())
+		}
+	}
+}
+
 func (this *Result[T]) IsNil() bool {
 	this.checkEvaluated()
 	if this.IsOk() {
@@ -676,6 +687,17 @@ func UnwapOptionValueOrNil[T any](v *Result[*option.Option[T]]) T {
 	return t
 }
 
+// Zip values of results as a tuple, or the first failure
+func Zip[A, B any](a *Result[A], b *Result[B]) *Result[*tuple.T2[A, B]] {
+	if a.IsError() {
+		return OfError[*tuple.T2[A, B]](a.Failure())
+	}
+	if b.IsError() {
+		return OfError[*tuple.T2[A, B]](b.Failure())
+	}
+	return OfValue(tuple.Of2(a.Get(), b.Get()))
+}
+
 func FlatMap[T, R any](v *Result[T], f func(T) *Result[R]) *Result[R] {
 	if v.IsOk() {
 		return f(v.Get())
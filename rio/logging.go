@@ -0,0 +1,73 @@
+package rio
+
+import (
+	"log/slog"
+	"reflect"
+	"time"
+
+	"github.com/mobilemindtech/go-io/logging"
+)
+
+// WithLogger set IO logger. By default the library logger is used
+func (this *IO[T]) WithLogger(logger *slog.Logger) *IO[T] {
+	this.logger = logger
+	return this
+}
+
+// log IO logger with IO name, type, caller and run id
+func (this *IO[T]) log() *slog.Logger {
+	if this.runId == "" {
+		this.runId = logging.NewRunId()
+	}
+	logger := logging.Or(this.logger).With(
+		logging.KeyIO, this.name,
+		logging.KeyType, reflect.TypeFor[T]().String(),
+		logging.KeyRunId, this.runId)
+	if this.filename != "" {
+		logger = logger.With(logging.Caller(getFileName(this.filename), this.line))
+	}
+	return logger
+}
+
+func (this *IO[T]) isDebug() bool {
+	return this.debug_ || this.debugAll
+}
+
+func (this *IO[T]) Log(msg string, args ...any) *IO[T] {
+	return Log(this, msg, args...)
+}
+
+func (this *IO[T]) LogSpan(msg string, args ...any) *IO[T] {
+	return LogSpan(this, msg, args...)
+}
+
+// Log io result. Values are logged with info level and errors with error level
+func Log[A any](io *IO[A], msg string, args ...any) *IO[A] {
+	return suspend(func(this *IO[A]) *IO[A] {
+		ref := io.unsafeRun(this.scope)
+		logResult(this.log(), ref, msg, args...)
+		return NewIOWithResult(ref.Get())
+	}).as("Log").WithLogger(io.logger)
+}
+
+// LogSpan log io start and end, with duration and result
+func LogSpan[A any](io *IO[A], msg string, args ...any) *IO[A] {
+	return suspend(func(this *IO[A]) *IO[A] {
+		logger := this.log()
+		logger.Info(msg+" start", args...)
+		start := time.Now()
+		ref := io.unsafeRun(this.scope)
+		logResult(logger.With("duration", time.Since(start)), ref, msg+" end", args...)
+		return NewIOWithResult(ref.Get())
+	}).as("LogSpan").WithLogger(io.logger)
+}
+
+func logResult[A any](logger *slog.Logger, ref *IO[A], msg string, args ...any) {
+	if ref.IsError() {
+		logger.Error(msg, append(args, logging.KeyError, ref.value.Failure())...)
+	} else if ref.IsEmpty() {
+		logger.Info(msg, append(args, "empty", true)...)
+	} else {
+		logger.Info(msg, append(args, "value", ref.UnsafeGet())...)
+	}
+}
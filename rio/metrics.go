@@ -0,0 +1,29 @@
+package rio
+
+import (
+	"time"
+
+	"github.com/mobilemindtech/go-io/metrics"
+)
+
+const metricsRuntime = "rio"
+
+// observeRun report run of the entry point IO or of IO named with As, if
+// metrics is enabled. Steps of rio combinators are reported as step durations
+// of the run
+func (this *IO[T]) observeRun(parent *scope, start time.Time, res *IO[T]) {
+	if this.name == "" || res == nil || !metrics.Enabled() {
+		return
+	}
+	if parent != nil && this.step {
+		if parent.name != "" {
+			metrics.ObserveStep(metricsRuntime, parent.name, this.name, start)
+		}
+		return
+	}
+	var err error
+	if res.IsError() {
+		err = res.value.Failure()
+	}
+	metrics.ObserveRun(metricsRuntime, this.name, start, err, res.IsEmpty())
+}
@@ -0,0 +1,12 @@
+package rio
+
+import "sync"
+
+// par run fns concurrently and wait all
+func par(fns ...func()) {
+	var wg sync.WaitGroup
+	for _, fn := range fns {
+		wg.Go(fn)
+	}
+	wg.Wait()
+}
@@ -1,18 +1,29 @@
 package rio
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
-	"reflect"
+	"iter"
+	"log/slog"
 	"runtime"
 	"runtime/debug"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/mobilemindtech/go-io/either"
+	"github.com/mobilemindtech/go-io/logging"
+	"github.com/mobilemindtech/go-io/metrics"
 	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/ratelimit"
 	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/trace"
+	"github.com/mobilemindtech/go-io/tuple"
 	"github.com/mobilemindtech/go-io/types/unit"
 	"github.com/mobilemindtech/go-io/util"
+	"github.com/mobilemindtech/go-io/validation"
 )
 
 type RIOError struct {
@@ -41,6 +52,12 @@ type IO[T any] struct {
 	debugAll    bool
 	name        string
 	debugInfo   string
+	filename    string
+	line        int
+	logger      *slog.Logger
+	runId       string
+	step        bool   // named by a rio combinator, not by As
+	scope       *scope // scope of the IOs run by computation, set on run copies
 	computation func(*IO[T]) *IO[T]
 }
 
@@ -99,6 +116,14 @@ func (this *IO[T]) RecoverIO(f func(error) *IO[T]) *IO[T] {
 	return RecoverIO(this, f)
 }
 
+func (this *IO[T]) RecoverStatus(status int, f func(error) T) *IO[T] {
+	return RecoverStatus(this, status, f)
+}
+
+func (this *IO[T]) Validate(validator func(T) *validation.V[T]) *IO[T] {
+	return Validate(this, validator)
+}
+
 func (this *IO[T]) CatchAll(f func(error) *IO[T]) *IO[T] {
 	return CatchAll(this, f)
 }
@@ -211,37 +236,74 @@ func (this *IO[T]) DebugAll() *IO[T] {
 	return this.withDebugInfo(filename, line)
 }
 
+// As name IO step. When tracing is enabled, the caller file and line are recorded
 func (this *IO[T]) As(name string) *IO[T] {
 	this.name = name
+	this.step = false
+	if this.filename == "" && trace.Enabled() {
+		this.filename, this.line = callerOutside()
+	}
+	return this
+}
+
+// as name IO of a rio combinator
+func (this *IO[T]) as(name string) *IO[T] {
+	this.As(name)
+	this.step = true
 	return this
 }
 
 func (this *IO[T]) withDebugInfo(filename string, lineNumber int) *IO[T] {
 	this.debugInfo = fmt.Sprintf("add in %v:%v",
 		getFileName(filename), lineNumber)
+	this.filename, this.line = filename, lineNumber
 	return this
 }
 
 func (this *IO[T]) UnsafeRun() *IO[T] {
+	return this.unsafeRun(nil)
+}
+
+// unsafeRun run IO on parent scope. Parent is nil for the run entry point
+func (this *IO[T]) unsafeRun(parent *scope) *IO[T] {
 
 	if this.debug_ {
-		_, filename, line, _ := runtime.Caller(1)
-		log.Printf("::> DEBUG IO(%v)[%v] %v, call in \n",
-			this.name, reflect.TypeFor[T]().String(), this.debugInfo, getFileName(filename), line)
+		filename, line := callerOutside()
+		this.log().Info("run", "call", fmt.Sprintf("%v:%v", getFileName(filename), line))
 	}
 
+	var span trace.Span
+
 	defer func() {
 		if err := recover(); err != nil {
-			log.Printf("::> ERROR IO(%v)[%v]: %v \n", this.name, reflect.TypeFor[T]().String(), err)
-			debug.PrintStack()
+			logger := this.log()
+			if this.isDebug() {
+				logger = logger.With(logging.KeyStack, string(debug.Stack()))
+			}
+			logger.Error("panic", logging.KeyError, err)
+			if span != nil {
+				span.RecordError(fmt.Errorf("%v", err))
+				span.End()
+			}
+			if this.name != "" {
+				metrics.IncPanic(metricsRuntime, this.name)
+			}
 		}
 	}()
 
 	if this.computation != nil {
-		return this.computation(this)
+		if err := parent.context().Err(); err != nil {
+			return NewErrorIO[T](err)
+		}
+		span = this.startSpan(parent)
+		start := time.Now()
+		res := this.computation(this.inScope(parent, span))
+		this.endSpan(span, res)
+		this.observeRun(parent, start, res)
+		return res
 	}
 
-	log.Println("::> WARNING IO(%v)[%v]: computation is nil", this.name, reflect.TypeFor[T]().String())
+	this.log().Warn("computation is nil")
 
 	return this
 }
@@ -263,19 +325,38 @@ func suspend[T any](f func(*IO[T]) *IO[T]) *IO[T] {
 func Pure[T any](value T) *IO[T] {
 	return suspend(func(_ *IO[T]) *IO[T] {
 		return NewIO(value)
-	}).As("Pure")
+	}).as("Pure")
 }
 
 // PureF value from func
 func PureF[T any](f func() T) *IO[T] {
 	return suspend(func(_ *IO[T]) *IO[T] {
 		return NewIO(f())
-	}).As("PureF")
+	}).as("PureF")
+}
+
+// FromEither create IO of right value, or failure of left value. Left values that
+// are not errors fail with *either.LeftError
+func FromEither[L, R any](e *either.Either[L, R]) *IO[R] {
+	return suspend(func(_ *IO[R]) *IO[R] {
+		return NewIOWithResult(result.MapToResultOption(either.ToResult(e)))
+	}).as("FromEither")
+}
+
+// Absolve IO of either into IO of right value, failing with left value
+func Absolve[L, R any](io *IO[*either.Either[L, R]]) *IO[R] {
+	return suspend(func(that *IO[R]) *IO[R] {
+		ref := io.unsafeRun(that.scope)
+		if ref.IsError() || ref.IsEmpty() {
+			return NewMaybeErrorIO[R](ref.Get())
+		}
+		return NewIOWithResult(result.MapToResultOption(either.ToResult(ref.UnsafeGet())))
+	}).as("Absolve")
 }
 
 func MapToEither[A any](io *IO[A]) *IO[*either.EitherE[A]] {
-	return suspend(func(_ *IO[*either.EitherE[A]]) *IO[*either.EitherE[A]] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[*either.EitherE[A]]) *IO[*either.EitherE[A]] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
 			return NewIO(either.LeftE[A](ref.Get().Failure()))
 		}
@@ -285,12 +366,12 @@ func MapToEither[A any](io *IO[A]) *IO[*either.EitherE[A]] {
 		}
 
 		return NewIO(either.RightE[A](ref.UnsafeGet()))
-	}).As("MapToEither")
+	}).as("MapToEither")
 }
 
 func MapToEitherOption[A any](io *IO[A]) *IO[*either.EitherE[*option.Option[A]]] {
-	return suspend(func(_ *IO[*either.EitherE[*option.Option[A]]]) *IO[*either.EitherE[*option.Option[A]]] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[*either.EitherE[*option.Option[A]]]) *IO[*either.EitherE[*option.Option[A]]] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
 			return NewIO(either.LeftE[*option.Option[A]](ref.Get().Failure()))
 		}
@@ -300,7 +381,7 @@ func MapToEitherOption[A any](io *IO[A]) *IO[*either.EitherE[*option.Option[A]]]
 		}
 
 		return NewIO(either.RightE(option.Some(ref.UnsafeGet())))
-	}).As("MapToEitherOption")
+	}).as("MapToEitherOption")
 }
 
 func MapToValue[A, B any](io *IO[A], value B) *IO[B] {
@@ -309,21 +390,52 @@ func MapToValue[A, B any](io *IO[A], value B) *IO[B] {
 	})
 }
 
+// FromValidation create IO of valid value, or a failure with *validation.Failure error
+func FromValidation[T any](v *validation.V[T]) *IO[T] {
+	return suspend(func(_ *IO[T]) *IO[T] {
+		return NewIOWithResult(result.MapToResultOption(v.ToResult()))
+	}).as("FromValidation")
+}
+
+// Validate computation value. Invalid value fail with *validation.Failure error
+func Validate[A any](io *IO[A], validator func(A) *validation.V[A]) *IO[A] {
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
+		if ref.IsError() || ref.IsEmpty() {
+			return NewIOWithResult(ref.Get())
+		}
+		return NewIOWithResult(result.MapToResultOption(validator(ref.UnsafeGet()).ToResult()))
+	}).as("Validate")
+}
+
+// RecoverValidation recover *validation.Failure errors
+func RecoverValidation[A any](io *IO[A], f func(*validation.Failure) A) *IO[A] {
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
+		if ref.IsError() {
+			if failure := validation.AsFailure(ref.Get().GetError()); failure.NonEmpty() {
+				return NewIO(f(failure.Get()))
+			}
+		}
+		return NewIOWithResult(ref.Get())
+	}).as("RecoverValidation")
+}
+
 // Map computation
 func Map[A, B any](io *IO[A], f func(A) B) *IO[B] {
-	return suspend(func(_ *IO[B]) *IO[B] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[B]) *IO[B] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[B](ref.Get())
 		}
 		return NewIO(f(ref.UnsafeGet()))
-	}).As("Map")
+	}).as("Map")
 }
 
 // SliceMap computation
 func SliceMap[A, B any](io *IO[[]A], f func(A) B) *IO[[]B] {
-	return suspend(func(_ *IO[[]B]) *IO[[]B] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[[]B]) *IO[[]B] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[[]B](ref.Get())
 		}
@@ -332,95 +444,95 @@ func SliceMap[A, B any](io *IO[[]A], f func(A) B) *IO[[]B] {
 			results = append(results, f(it))
 		}
 		return NewIO(results)
-	}).As("SliceMap")
+	}).as("SliceMap")
 }
 
 func MapToUnit[A any](io *IO[A]) *IO[*unit.Unit] {
-	return suspend(func(_ *IO[*unit.Unit]) *IO[*unit.Unit] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[*unit.Unit]) *IO[*unit.Unit] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[*unit.Unit](ref.Get())
 		}
 		return NewIO(unit.OfUnit())
-	}).As("MapToUnit")
+	}).as("MapToUnit")
 }
 
 // FlatMap computation
 func FlatMap[A, B any](io *IO[A], f func(A) *IO[B]) *IO[B] {
-	return suspend(func(_ *IO[B]) *IO[B] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[B]) *IO[B] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[B](ref.Get())
 		}
-		return f(ref.UnsafeGet()).UnsafeRun()
-	}).As("FlatMap")
+		return f(ref.UnsafeGet()).unsafeRun(that.scope)
+	}).as("FlatMap")
 }
 
 // SliceFlatMap computation
 func SliceFlatMap[A, B any](io *IO[[]A], f func(A) *IO[B]) *IO[[]B] {
-	return suspend(func(_ *IO[[]B]) *IO[[]B] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[[]B]) *IO[[]B] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[[]B](ref.Get())
 		}
 
 		var results []B
 		for _, it := range ref.UnsafeGet() {
-			res := f(it).UnsafeRun()
+			res := f(it).unsafeRun(that.scope)
 			if ref.IsError() || ref.IsEmpty() {
 				return NewMaybeErrorIO[[]B](res.Get())
 			}
 			results = append(results, res.UnsafeGet())
 		}
 		return NewIO(results)
-	}).As("SliceFlatMap")
+	}).as("SliceFlatMap")
 }
 
 // AndThan computation
 func AndThan[A, B any](io *IO[A], f func() *IO[B]) *IO[B] {
-	return suspend(func(_ *IO[B]) *IO[B] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[B]) *IO[B] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[B](ref.Get())
 		}
-		return f().UnsafeRun()
-	}).As("AndThan")
+		return f().unsafeRun(that.scope)
+	}).as("AndThan")
 }
 
 func AndThanIO[A, B any](ioA *IO[A], ioB *IO[B]) *IO[B] {
-	return suspend(func(_ *IO[B]) *IO[B] {
-		ref := ioA.UnsafeRun()
+	return suspend(func(that *IO[B]) *IO[B] {
+		ref := ioA.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[B](ref.Get())
 		}
-		return ioB.UnsafeRun()
-	}).As("AndThanIO")
+		return ioB.unsafeRun(that.scope)
+	}).as("AndThanIO")
 }
 
 func Then[A, B any](io *IO[A], f func(A) B) *IO[B] {
-	return suspend(func(_ *IO[B]) *IO[B] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[B]) *IO[B] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[B](ref.Get())
 		}
 		return NewIO(f(ref.UnsafeGet()))
-	}).As("Then")
+	}).as("Then")
 }
 
 func ThenIO[A, B any](io *IO[A], f func(A) *IO[B]) *IO[B] {
-	return suspend(func(_ *IO[B]) *IO[B] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[B]) *IO[B] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[B](ref.Get())
 		}
-		return f(ref.UnsafeGet()).UnsafeRun()
-	}).As("ThenIO")
+		return f(ref.UnsafeGet()).unsafeRun(that.scope)
+	}).as("ThenIO")
 }
 
 // Filter computation
 func Filter[A any](io *IO[A], f func(A) bool) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[A](ref.Get())
 		}
@@ -429,26 +541,26 @@ func Filter[A any](io *IO[A], f func(A) bool) *IO[A] {
 		} else {
 			return NewEmptyIO[A]()
 		}
-	}).As("Filter")
+	}).as("Filter")
 }
 
 // Foreach computation
 func Foreach[A any](io *IO[A], f func(A)) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[A](ref.Get())
 		}
 		f(ref.UnsafeGet())
 		return NewIO(ref.UnsafeGet())
 
-	}).As("Foreach")
+	}).as("Foreach")
 }
 
 // ForeachError computation
 func ForeachError[A any](io *IO[A], f func(error)) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 
 		if ref.IsError() {
 			f(ref.Get().GetError())
@@ -459,29 +571,77 @@ func ForeachError[A any](io *IO[A], f func(error)) *IO[A] {
 		}
 		return NewIO(ref.UnsafeGet())
 
-	}).As("ForeachError")
+	}).as("ForeachError")
 }
 
 // Exec computation
 func Exec[A any](io *IO[A], f func(A) *IO[*unit.Unit]) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[A](ref.Get())
 		}
-		res := f(ref.UnsafeGet()).UnsafeRun()
+		res := f(ref.UnsafeGet()).unsafeRun(that.scope)
 		if res.IsError() || res.IsEmpty() {
 			return NewMaybeErrorIO[A](res.Get())
 		}
 		return NewIO(ref.UnsafeGet())
 
-	}).As("Exec")
+	}).as("Exec")
+}
+
+// FromSeq IO of sequence. The sequence is not consumed
+func FromSeq[A any](seq iter.Seq[A]) *IO[iter.Seq[A]] {
+	return Pure(seq).as("FromSeq")
+}
+
+// SeqMap lazy map of sequence values
+func SeqMap[A, B any](io *IO[iter.Seq[A]], f func(A) B) *IO[iter.Seq[B]] {
+	return Map(io, func(seq iter.Seq[A]) iter.Seq[B] {
+		return func(yield func(B) bool) {
+			for it := range seq {
+				if !yield(f(it)) {
+					return
+				}
+			}
+		}
+	}).as("SeqMap")
+}
+
+// SeqFilter lazy filter of sequence values
+func SeqFilter[A any](io *IO[iter.Seq[A]], f func(A) bool) *IO[iter.Seq[A]] {
+	return Map(io, func(seq iter.Seq[A]) iter.Seq[A] {
+		return func(yield func(A) bool) {
+			for it := range seq {
+				if f(it) && !yield(it) {
+					return
+				}
+			}
+		}
+	}).as("SeqFilter")
+}
+
+// ForeachSeq consume sequence calling f for each value
+func ForeachSeq[A any](io *IO[iter.Seq[A]], f func(A)) *IO[*unit.Unit] {
+	return Map(io, func(seq iter.Seq[A]) *unit.Unit {
+		for it := range seq {
+			f(it)
+		}
+		return unit.OfUnit()
+	}).as("ForeachSeq")
+}
+
+// SeqToSlice consume sequence into slice
+func SeqToSlice[A any](io *IO[iter.Seq[A]]) *IO[[]A] {
+	return Map(io, func(seq iter.Seq[A]) []A {
+		return slices.Collect(seq)
+	}).as("SeqToSlice")
 }
 
 // SliceForeach computation
 func SliceForeach[A any](io *IO[[]A], f func(A)) *IO[[]A] {
-	return suspend(func(_ *IO[[]A]) *IO[[]A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[[]A]) *IO[[]A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[[]A](ref.Get())
 		}
@@ -490,13 +650,13 @@ func SliceForeach[A any](io *IO[[]A], f func(A)) *IO[[]A] {
 		}
 		return NewIO(ref.UnsafeGet())
 
-	}).As("SliceForeach")
+	}).as("SliceForeach")
 }
 
 // SliceFilter computation
 func SliceFilter[A any](io *IO[[]A], f func(A) bool) *IO[[]A] {
-	return suspend(func(_ *IO[[]A]) *IO[[]A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[[]A]) *IO[[]A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[[]A](ref.Get())
 		}
@@ -508,20 +668,20 @@ func SliceFilter[A any](io *IO[[]A], f func(A) bool) *IO[[]A] {
 		}
 		return NewIO(results)
 
-	}).As("SliceFilter")
+	}).as("SliceFilter")
 }
 
 // SliceExec computation
 func SliceExec[A any](io *IO[[]A], f func(A) *result.Result[*unit.Unit]) *IO[[]A] {
-	return suspend(func(_ *IO[[]A]) *IO[[]A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[[]A]) *IO[[]A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() || ref.IsEmpty() {
 			return NewMaybeErrorIO[[]A](ref.Get())
 		}
 		for _, it := range ref.UnsafeGet() {
 			res := Attempt[*unit.Unit](func() *result.Result[*unit.Unit] {
 				return f(it)
-			}).UnsafeRun()
+			}).unsafeRun(that.scope)
 
 			if res.IsError() || res.IsEmpty() {
 				return NewMaybeErrorIO[[]A](res.Get())
@@ -529,43 +689,43 @@ func SliceExec[A any](io *IO[[]A], f func(A) *result.Result[*unit.Unit]) *IO[[]A
 		}
 		return NewIO(ref.UnsafeGet())
 
-	}).As("SliceExec")
+	}).as("SliceExec")
 }
 
 // OrElse computation
 func OrElse[A any](io *IO[A], f func() *IO[A]) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
 			return NewErrorIO[A](ref.Get().Failure())
 		}
 		if ref.IsEmpty() {
-			return f().UnsafeRun()
+			return f().unsafeRun(that.scope)
 		} else {
 			return NewIO(ref.UnsafeGet())
 		}
-	}).As("OrElse")
+	}).as("OrElse")
 }
 
 // OrElseIO computation
 func OrElseIO[A any](io *IO[A], otherIO *IO[A]) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
 			return NewErrorIO[A](ref.Get().Failure())
 		}
 		if ref.IsEmpty() {
-			return otherIO.UnsafeRun()
+			return otherIO.unsafeRun(that.scope)
 		} else {
 			return NewIO(ref.UnsafeGet())
 		}
-	}).As("OrElseIO")
+	}).as("OrElseIO")
 }
 
 // Or computation
 func Or[A any](io *IO[A], f func() A) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
 			return NewErrorIO[A](ref.Get().Failure())
 		}
@@ -574,12 +734,12 @@ func Or[A any](io *IO[A], f func() A) *IO[A] {
 		} else {
 			return NewIO(ref.UnsafeGet())
 		}
-	}).As("Or")
+	}).as("Or")
 }
 
 func IfEmpty[A any](io *IO[A], f func()) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
 			return NewErrorIO[A](ref.Get().Failure())
 		}
@@ -588,72 +748,100 @@ func IfEmpty[A any](io *IO[A], f func()) *IO[A] {
 			return NewEmptyIO[A]()
 		}
 		return NewIO[A](ref.UnsafeGet())
-	}).As("IfEmpty")
+	}).as("IfEmpty")
 }
 
 // Recover computation
 func Recover[A any](io *IO[A], f func(error) A) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
 			return NewIO(f(ref.Get().GetError()))
 		}
 		return NewIOWithResult(ref.Get())
-	}).As("Recover")
+	}).as("Recover")
 }
 
 // RecoverIO computation
 func RecoverIO[A any](io *IO[A], f func(error) *IO[A]) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
-			return f(ref.Get().GetError()).UnsafeRun()
+			return f(ref.Get().GetError()).unsafeRun(that.scope)
+		}
+		return NewIOWithResult(ref.Get())
+	}).as("RecoverIO")
+}
+
+// StatusError is an error with a status code, like http errors
+type StatusError interface {
+	error
+	Status() int
+}
+
+// RecoverStatus recover errors that have the given status code
+func RecoverStatus[A any](io *IO[A], status int, f func(error) A) *IO[A] {
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
+		if ref.IsError() && hasStatus(ref.Get().GetError(), status) {
+			return NewIO(f(ref.Get().GetError()))
+		}
+		return NewIOWithResult(ref.Get())
+	}).as("RecoverStatus")
+}
+
+// RecoverStatusIO recover errors that have the given status code
+func RecoverStatusIO[A any](io *IO[A], status int, f func(error) *IO[A]) *IO[A] {
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
+		if ref.IsError() && hasStatus(ref.Get().GetError(), status) {
+			return f(ref.Get().GetError()).unsafeRun(that.scope)
 		}
 		return NewIOWithResult(ref.Get())
-	}).As("RecoverIO")
+	}).as("RecoverStatusIO")
 }
 
 // OnError computation
 func OnError[A any](io *IO[A], f func(error)) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
 			f(ref.Get().GetError())
 		}
 		return NewIOWithResult(ref.Get())
-	}).As("OnError")
+	}).as("OnError")
 }
 
 // Catch computation
 func Catch[A any](io *IO[A], f func(error) *result.Result[A]) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
 			res := f(ref.Get().GetError())
 			return NewMaybeErrorIO[A](res)
 		}
 		return NewIOWithResult(ref.Get())
-	}).As("Catch")
+	}).as("Catch")
 }
 
 // CatchAll computation
 func CatchAll[A any](io *IO[A], f func(error) *IO[A]) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		if ref.IsError() {
-			return f(ref.Get().GetError()).UnsafeRun()
+			return f(ref.Get().GetError()).unsafeRun(that.scope)
 		}
 		return NewIOWithResult(ref.Get())
-	}).As("CatchAll")
+	}).as("CatchAll")
 }
 
 // Ensure computation
 func Ensure[A any](io *IO[A], f func()) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(that *IO[A]) *IO[A] {
+		ref := io.unsafeRun(that.scope)
 		f()
 		return NewIOWithResult(ref.Get())
-	}).As("Ensure")
+	}).as("Ensure")
 }
 
 // EnsureUnit
@@ -661,28 +849,28 @@ func EnsureUnit(f func()) *IO[*unit.Unit] {
 	return suspend(func(_ *IO[*unit.Unit]) *IO[*unit.Unit] {
 		f()
 		return NewIO(unit.OfUnit())
-	}).As("EnsureUnit")
+	}).as("EnsureUnit")
 }
 
 // EnsureIO
 func EnsureIO[T any](io *IO[T], f func()) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
 		f()
-		return io.UnsafeRun()
-	}).As("EnsureIO")
+		return io.unsafeRun(that.scope)
+	}).as("EnsureIO")
 }
 
 // Debug computation
 func Debug[A any](io *IO[A], label ...string) *IO[A] {
-	return suspend(func(_ *IO[A]) *IO[A] {
-		ref := io.UnsafeRun()
+	return suspend(func(this *IO[A]) *IO[A] {
+		ref := io.unsafeRun(this.scope)
 		if len(label) > 0 {
-			log.Printf("DEBUG IO[%v]>> %v", label[0], ref)
+			this.log().Info(label[0], "result", ref)
 		} else {
-			log.Printf("DEBUG IO>> %v", ref)
+			this.log().Info("debug", "result", ref)
 		}
 		return NewIOWithResult(ref.Get())
-	}).As("Debug")
+	}).as("Debug").WithLogger(io.logger)
 }
 
 // Attempt computation
@@ -702,7 +890,7 @@ func Attempt[A any](f func() *result.Result[A]) *IO[A] {
 		}
 		io = NewMaybeErrorIO[A](res)
 		return
-	}).As("Attempt")
+	}).as("Attempt")
 }
 
 // AttemptThen computation
@@ -715,7 +903,7 @@ func AttemptThen[A, B any](ioA *IO[A], f func(A) *result.Result[B]) *IO[B] {
 			}
 		}()
 
-		resultIO := ioA.UnsafeRun()
+		resultIO := ioA.unsafeRun(that.scope)
 
 		if resultIO.IsError() {
 			io = NewErrorIO[B](resultIO.Get().Failure())
@@ -734,7 +922,7 @@ func AttemptThen[A, B any](ioA *IO[A], f func(A) *result.Result[B]) *IO[B] {
 		}
 		io = NewMaybeErrorIO[B](res)
 		return
-	}).As("AttemptThen")
+	}).as("AttemptThen")
 }
 
 func AndThenAttempt[A, B any](ioA *IO[A], f func() *result.Result[B]) *IO[B] {
@@ -746,7 +934,7 @@ func AndThenAttempt[A, B any](ioA *IO[A], f func() *result.Result[B]) *IO[B] {
 			}
 		}()
 
-		resultIO := ioA.UnsafeRun()
+		resultIO := ioA.unsafeRun(that.scope)
 
 		if resultIO.IsError() {
 			io = NewErrorIO[B](resultIO.Get().Failure())
@@ -765,7 +953,7 @@ func AndThenAttempt[A, B any](ioA *IO[A], f func() *result.Result[B]) *IO[B] {
 		}
 		io = NewMaybeErrorIO[B](res)
 		return
-	}).As("AttemptThen")
+	}).as("AttemptThen")
 }
 
 // AttemptThenOfOption computation
@@ -778,7 +966,7 @@ func AttemptThenOfOption[A, B any](ioA *IO[A], f func(A) *result.Result[*option.
 			}
 		}()
 
-		resultIO := ioA.UnsafeRun()
+		resultIO := ioA.unsafeRun(that.scope)
 
 		if resultIO.IsError() {
 			io = NewErrorIO[B](resultIO.Get().Failure())
@@ -792,7 +980,7 @@ func AttemptThenOfOption[A, B any](ioA *IO[A], f func(A) *result.Result[*option.
 
 		io = NewIOWithResult(f(resultIO.UnsafeGet()))
 		return
-	}).As("AttemptThenOfOption")
+	}).as("AttemptThenOfOption")
 }
 
 // AttemptThenOfOption computation
@@ -805,7 +993,7 @@ func AttemptThenOfIO[A, B any](ioA *IO[A], f func(A) *IO[B]) *IO[B] {
 			}
 		}()
 
-		resultIO := ioA.UnsafeRun()
+		resultIO := ioA.unsafeRun(that.scope)
 
 		if resultIO.IsError() {
 			io = NewErrorIO[B](resultIO.Get().Failure())
@@ -817,211 +1005,104 @@ func AttemptThenOfIO[A, B any](ioA *IO[A], f func(A) *IO[B]) *IO[B] {
 			return
 		}
 
-		io = f(resultIO.UnsafeGet()).UnsafeRun()
+		io = f(resultIO.UnsafeGet()).unsafeRun(that.scope)
 		return
-	}).As("AttemptThenOfIO")
-}
-
-// FlatMap2 computation
-func FlatMap2[A, B, T any](a *IO[A], b *IO[B], f func(A, B) *IO[T]) *IO[T] {
-	return suspend(func(that *IO[T]) *IO[T] {
-		return FlatMap(a, func(valA A) *IO[T] {
-			return FlatMap(b, func(valB B) *IO[T] {
-				return f(valA, valB)
-			})
-		}).UnsafeRun()
-	}).As("FlatMap2")
-}
-
-// FlatMap3 computation
-func FlatMap3[A, B, C, T any](a *IO[A], b *IO[B], c *IO[C], f func(A, B, C) *IO[T]) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap2(a, b, func(valA A, valB B) *IO[T] {
-			return FlatMap(c, func(valC C) *IO[T] {
-				return f(valA, valB, valC)
-			})
-		}).UnsafeRun()
-	}).As("FlatMap3")
+	}).as("AttemptThenOfIO")
 }
 
-// FlatMap4 computation
-func FlatMap4[A, B, C, D, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], f func(A, B, C, D) *IO[T]) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap3(a, b, c, func(valA A, valB B, valC C) *IO[T] {
-			return FlatMap(d, func(valD D) *IO[T] {
-				return f(valA, valB, valC, valD)
-			})
-		}).UnsafeRun()
-	}).As("FlatMap4")
-}
-
-// FlatMap5 computation
-func FlatMap5[A, B, C, D, E, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f func(A, B, C, D, E) *IO[T]) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap4(a, b, c, d, func(valA A, valB B, valC C, valD D) *IO[T] {
-			return FlatMap(e, func(valE E) *IO[T] {
-				return f(valA, valB, valC, valD, valE)
-			})
-		}).UnsafeRun()
-	}).As("FlatMap5")
-}
-
-// FlatMap6 computation
-func FlatMap6[A, B, C, D, E, F, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], fn func(A, B, C, D, E, F) *IO[T]) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap5(a, b, c, d, e, func(valA A, valB B, valC C, valD D, valE E) *IO[T] {
-			return FlatMap(f, func(valF F) *IO[T] {
-				return fn(valA, valB, valC, valD, valE, valF)
-			})
-		}).UnsafeRun()
-	}).As("FlatMap6")
-}
-
-// FlatMap7 computation
-func FlatMap7[A, B, C, D, E, F, G, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], fn func(A, B, C, D, E, F, G) *IO[T]) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap6(a, b, c, d, e, f, func(valA A, valB B, valC C, valD D, valE E, valF F) *IO[T] {
-			return FlatMap(g, func(valG G) *IO[T] {
-				return fn(valA, valB, valC, valD, valE, valF, valG)
-			})
-		}).UnsafeRun()
-	}).As("FlatMap7")
-}
-
-// FlatMap8 computation
-func FlatMap8[A, B, C, D, E, F, G, H, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], fn func(A, B, C, D, E, F, G, H) *IO[T]) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap7(a, b, c, d, e, f, g, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G) *IO[T] {
-			return FlatMap(h, func(valH H) *IO[T] {
-				return fn(valA, valB, valC, valD, valE, valF, valG, valH)
-			})
-		}).UnsafeRun()
-	}).As("FlatMap8")
-}
-
-// FlatMap9 computation
-func FlatMap9[A, B, C, D, E, F, G, H, I, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], fn func(A, B, C, D, E, F, G, H, I) *IO[T]) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap8(a, b, c, d, e, f, g, h, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G, valH H) *IO[T] {
-			return FlatMap(i, func(valI I) *IO[T] {
-				return fn(valA, valB, valC, valD, valE, valF, valG, valH, valI)
-			})
-		}).UnsafeRun()
-	}).As("FlatMap9")
-}
-
-// FlatMap10 computation
-func FlatMap10[A, B, C, D, E, F, G, H, I, J, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], j *IO[J], fn func(A, B, C, D, E, F, G, H, I, J) *IO[T]) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap9(a, b, c, d, e, f, g, h, i, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G, valH H, valI I) *IO[T] {
-			return FlatMap(j, func(valJ J) *IO[T] {
-				return fn(valA, valB, valC, valD, valE, valF, valG, valH, valI, valJ)
-			})
-		}).UnsafeRun()
-	}).As("FlatMap10")
-}
-
-// Map2 computation
-func Map2[A, B, T any](a *IO[A], b *IO[B], f func(A, B) T) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap(a, func(valA A) *IO[T] {
-			return FlatMap(b, func(valB B) *IO[T] {
-				return NewIO(f(valA, valB))
-			})
-		}).UnsafeRun()
-	}).As("Map2")
+// Unzip computation, split a tuple IO in two IOs. The tuple IO runs once
+func Unzip[A, B any](io *IO[*tuple.T2[A, B]]) (*IO[A], *IO[B]) {
+	run := sync.OnceValue(io.UnsafeRun)
+	ioA := suspend(func(_ *IO[A]) *IO[A] {
+		ref := run()
+		if ref.IsError() || ref.IsEmpty() {
+			return NewMaybeErrorIO[A](ref.Get())
+		}
+		return NewIO(ref.UnsafeGet().V1())
+	}).as("Unzip")
+	ioB := suspend(func(_ *IO[B]) *IO[B] {
+		ref := run()
+		if ref.IsError() || ref.IsEmpty() {
+			return NewMaybeErrorIO[B](ref.Get())
+		}
+		return NewIO(ref.UnsafeGet().V2())
+	}).as("Unzip")
+	return ioA, ioB
 }
 
-// Map3 computation
-func Map3[A, B, C, T any](a *IO[A], b *IO[B], c *IO[C], f func(A, B, C) T) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap2(a, b, func(valA A, valB B) *IO[T] {
-			return FlatMap(c, func(valC C) *IO[T] {
-				return NewIO(f(valA, valB, valC))
-			})
-		}).UnsafeRun()
-	}).As("Map3")
-}
+// WithContext computation, fail with context error if ctx is done before io
+// completes. The io runs with a context that is cancelled when WithContext
+// returns, so the next steps of an abandoned io don't run, and Sleep and
+// AttemptContext stop waiting
+func WithContext[T any](ctx context.Context, io *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		if err := ctx.Err(); err != nil {
+			return NewErrorIO[T](err)
+		}
 
-// Map4 computation
-func Map4[A, B, C, D, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], f func(A, B, C, D) T) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap3(a, b, c, func(valA A, valB B, valC C) *IO[T] {
-			return FlatMap(d, func(valD D) *IO[T] {
-				return NewIO(f(valA, valB, valC, valD))
-			})
-		}).UnsafeRun()
-	}).As("Map4")
-}
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
 
-// Map5 computation
-func Map5[A, B, C, D, E, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f func(A, B, C, D, E) T) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap4(a, b, c, d, func(valA A, valB B, valC C, valD D) *IO[T] {
-			return FlatMap(e, func(valE E) *IO[T] {
-				return NewIO(f(valA, valB, valC, valD, valE))
-			})
-		}).UnsafeRun()
-	}).As("Map5")
-}
+		done := make(chan *result.Result[*option.Option[T]], 1)
 
-// Map6 computation
-func Map6[A, B, C, D, E, F, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], fn func(A, B, C, D, E, F) T) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap5(a, b, c, d, e, func(valA A, valB B, valC C, valD D, valE E) *IO[T] {
-			return FlatMap(f, func(valF F) *IO[T] {
-				return NewIO(fn(valA, valB, valC, valD, valE, valF))
-			})
-		}).UnsafeRun()
-	}).As("Map6")
-}
+		go func() {
+			done <- unsafeRunFrom(io, that.scope.withContext(runCtx))
+		}()
 
-// Map7 computation
-func Map7[A, B, C, D, E, F, G, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], fn func(A, B, C, D, E, F, G) T) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap6(a, b, c, d, e, f, func(valA A, valB B, valC C, valD D, valE E, valF F) *IO[T] {
-			return FlatMap(g, func(valG G) *IO[T] {
-				return NewIO(fn(valA, valB, valC, valD, valE, valF, valG))
-			})
-		}).UnsafeRun()
-	}).As("Map7")
+		select {
+		case <-ctx.Done():
+			return NewErrorIO[T](ctx.Err())
+		case res := <-done:
+			return NewIOWithResult(res)
+		}
+	}).as("WithContext")
+}
+
+// Sleep computation, wait d or until the run context is done
+func Sleep(d time.Duration) *IO[*unit.Unit] {
+	return suspend(func(that *IO[*unit.Unit]) *IO[*unit.Unit] {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-that.scope.context().Done():
+			return NewErrorIO[*unit.Unit](that.scope.context().Err())
+		case <-timer.C:
+			return NewIO(unit.OfUnit())
+		}
+	}).as("Sleep")
 }
 
-// Map8 computation
-func Map8[A, B, C, D, E, F, G, H, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], fn func(A, B, C, D, E, F, G, H) T) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap7(a, b, c, d, e, f, g, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G) *IO[T] {
-			return FlatMap(h, func(valH H) *IO[T] {
-				return NewIO(fn(valA, valB, valC, valD, valE, valF, valG, valH))
-			})
-		}).UnsafeRun()
-	}).As("Map8")
+// AttemptContext computation, like Attempt with the run context. The context
+// is done when the ctx of WithContext is done
+func AttemptContext[A any](f func(context.Context) *result.Result[A]) *IO[A] {
+	return suspend(func(that *IO[A]) *IO[A] {
+		ctx := that.scope.context()
+		return Attempt(func() *result.Result[A] { return f(ctx) }).unsafeRun(that.scope)
+	}).as("AttemptContext")
 }
 
-// Map9 computation
-func Map9[A, B, C, D, E, F, G, H, I, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], fn func(A, B, C, D, E, F, G, H, I) T) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap8(a, b, c, d, e, f, g, h, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G, valH H) *IO[T] {
-			return FlatMap(i, func(valI I) *IO[T] {
-				return NewIO(fn(valA, valB, valC, valD, valE, valF, valG, valH, valI))
-			})
-		}).UnsafeRun()
-	}).As("Map9")
+// RateLimited wait limiter slot of key before run IO. The wait stops when the
+// run context of WithContext is done
+func RateLimited[T any](limiter ratelimit.Limiter, io *IO[T], key ...string) *IO[T] {
+	limiterKey := ""
+	if len(key) > 0 {
+		limiterKey = key[0]
+	}
+	return suspend(func(that *IO[T]) *IO[T] {
+		if err := limiter.Wait(that.scope.context(), limiterKey); err != nil {
+			return NewErrorIO[T](err)
+		}
+		return io.unsafeRun(that.scope)
+	}).as("RateLimited")
 }
 
-// Map10 computation
-func Map10[A, B, C, D, E, F, G, H, I, J, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], j *IO[J], fn func(A, B, C, D, E, F, G, H, I, J) T) *IO[T] {
-	return suspend(func(_ *IO[T]) *IO[T] {
-		return FlatMap9(a, b, c, d, e, f, g, h, i, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G, valH H, valI I) *IO[T] {
-			return FlatMap(j, func(valJ J) *IO[T] {
-				return NewIO(fn(valA, valB, valC, valD, valE, valF, valG, valH, valI, valJ))
-			})
-		}).UnsafeRun()
-	}).As("Map10")
+// UnsafeRun run IO computations
+func UnsafeRun[T any](io *IO[T]) *result.Result[*option.Option[T]] {
+	return unsafeRunFrom(io, nil)
 }
 
-// UnsafeRun run IO computations
-func UnsafeRun[T any](io *IO[T]) (r *result.Result[*option.Option[T]]) {
+// unsafeRunFrom run IO computations on parent scope
+func unsafeRunFrom[T any](io *IO[T], parent *scope) (r *result.Result[*option.Option[T]]) {
 
 	defer func() {
 		if err := recover(); err != nil {
@@ -1035,7 +1116,7 @@ func UnsafeRun[T any](io *IO[T]) (r *result.Result[*option.Option[T]]) {
 		}
 	}()
 
-	r = io.UnsafeRun().Get()
+	r = io.unsafeRun(parent).Get()
 	return
 }
 
@@ -1060,15 +1141,18 @@ func getFileName(name string) string {
 	return sp[len(sp)-1]
 }
 
+func hasStatus(err error, status int) bool {
+	var statusErr StatusError
+	return errors.As(err, &statusErr) && statusErr.Status() == status
+}
+
 func catchErrorForAttempt[A any](err any, io *IO[A]) *IO[A] {
 
 	stacktrace := string(debug.Stack())
 
-	//if io.debug_ {
-	log.Printf(">> DEBUG IO(%v)[%v] %v\n",
-		io.name, reflect.TypeFor[A]().String(), io.debugInfo)
-	log.Printf(">> DEBUG IO(%v)\n\n%v\n\n", io.name, stacktrace)
-	//}
+	if io.isDebug() {
+		io.log().Info("attempt panic", logging.KeyError, err, logging.KeyStack, stacktrace)
+	}
 
 	rioError := &RIOError{
 		Message:    fmt.Sprintf("%v", err),
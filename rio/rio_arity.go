@@ -0,0 +1,608 @@
+// Code generated by go-io/internal/gen. DO NOT EDIT.
+
+package rio
+
+import "github.com/mobilemindtech/go-io/tuple"
+
+// FlatMap2 computation
+func FlatMap2[A, B, T any](a *IO[A], b *IO[B], fn func(A, B) *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap(a, func(valA A) *IO[T] {
+			return FlatMap(b, func(valB B) *IO[T] {
+				return fn(valA, valB)
+			})
+		}).unsafeRun(that.scope)
+	}).as("FlatMap2")
+}
+
+// FlatMap3 computation
+func FlatMap3[A, B, C, T any](a *IO[A], b *IO[B], c *IO[C], fn func(A, B, C) *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap2(a, b, func(valA A, valB B) *IO[T] {
+			return FlatMap(c, func(valC C) *IO[T] {
+				return fn(valA, valB, valC)
+			})
+		}).unsafeRun(that.scope)
+	}).as("FlatMap3")
+}
+
+// FlatMap4 computation
+func FlatMap4[A, B, C, D, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], fn func(A, B, C, D) *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap3(a, b, c, func(valA A, valB B, valC C) *IO[T] {
+			return FlatMap(d, func(valD D) *IO[T] {
+				return fn(valA, valB, valC, valD)
+			})
+		}).unsafeRun(that.scope)
+	}).as("FlatMap4")
+}
+
+// FlatMap5 computation
+func FlatMap5[A, B, C, D, E, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], fn func(A, B, C, D, E) *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap4(a, b, c, d, func(valA A, valB B, valC C, valD D) *IO[T] {
+			return FlatMap(e, func(valE E) *IO[T] {
+				return fn(valA, valB, valC, valD, valE)
+			})
+		}).unsafeRun(that.scope)
+	}).as("FlatMap5")
+}
+
+// FlatMap6 computation
+func FlatMap6[A, B, C, D, E, F, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], fn func(A, B, C, D, E, F) *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap5(a, b, c, d, e, func(valA A, valB B, valC C, valD D, valE E) *IO[T] {
+			return FlatMap(f, func(valF F) *IO[T] {
+				return fn(valA, valB, valC, valD, valE, valF)
+			})
+		}).unsafeRun(that.scope)
+	}).as("FlatMap6")
+}
+
+// FlatMap7 computation
+func FlatMap7[A, B, C, D, E, F, G, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], fn func(A, B, C, D, E, F, G) *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap6(a, b, c, d, e, f, func(valA A, valB B, valC C, valD D, valE E, valF F) *IO[T] {
+			return FlatMap(g, func(valG G) *IO[T] {
+				return fn(valA, valB, valC, valD, valE, valF, valG)
+			})
+		}).unsafeRun(that.scope)
+	}).as("FlatMap7")
+}
+
+// FlatMap8 computation
+func FlatMap8[A, B, C, D, E, F, G, H, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], fn func(A, B, C, D, E, F, G, H) *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap7(a, b, c, d, e, f, g, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G) *IO[T] {
+			return FlatMap(h, func(valH H) *IO[T] {
+				return fn(valA, valB, valC, valD, valE, valF, valG, valH)
+			})
+		}).unsafeRun(that.scope)
+	}).as("FlatMap8")
+}
+
+// FlatMap9 computation
+func FlatMap9[A, B, C, D, E, F, G, H, I, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], fn func(A, B, C, D, E, F, G, H, I) *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap8(a, b, c, d, e, f, g, h, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G, valH H) *IO[T] {
+			return FlatMap(i, func(valI I) *IO[T] {
+				return fn(valA, valB, valC, valD, valE, valF, valG, valH, valI)
+			})
+		}).unsafeRun(that.scope)
+	}).as("FlatMap9")
+}
+
+// FlatMap10 computation
+func FlatMap10[A, B, C, D, E, F, G, H, I, J, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], j *IO[J], fn func(A, B, C, D, E, F, G, H, I, J) *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap9(a, b, c, d, e, f, g, h, i, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G, valH H, valI I) *IO[T] {
+			return FlatMap(j, func(valJ J) *IO[T] {
+				return fn(valA, valB, valC, valD, valE, valF, valG, valH, valI, valJ)
+			})
+		}).unsafeRun(that.scope)
+	}).as("FlatMap10")
+}
+
+// Map2 computation
+func Map2[A, B, T any](a *IO[A], b *IO[B], fn func(A, B) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap(a, func(valA A) *IO[T] {
+			return FlatMap(b, func(valB B) *IO[T] {
+				return NewIO(fn(valA, valB))
+			})
+		}).unsafeRun(that.scope)
+	}).as("Map2")
+}
+
+// Map3 computation
+func Map3[A, B, C, T any](a *IO[A], b *IO[B], c *IO[C], fn func(A, B, C) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap2(a, b, func(valA A, valB B) *IO[T] {
+			return FlatMap(c, func(valC C) *IO[T] {
+				return NewIO(fn(valA, valB, valC))
+			})
+		}).unsafeRun(that.scope)
+	}).as("Map3")
+}
+
+// Map4 computation
+func Map4[A, B, C, D, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], fn func(A, B, C, D) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap3(a, b, c, func(valA A, valB B, valC C) *IO[T] {
+			return FlatMap(d, func(valD D) *IO[T] {
+				return NewIO(fn(valA, valB, valC, valD))
+			})
+		}).unsafeRun(that.scope)
+	}).as("Map4")
+}
+
+// Map5 computation
+func Map5[A, B, C, D, E, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], fn func(A, B, C, D, E) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap4(a, b, c, d, func(valA A, valB B, valC C, valD D) *IO[T] {
+			return FlatMap(e, func(valE E) *IO[T] {
+				return NewIO(fn(valA, valB, valC, valD, valE))
+			})
+		}).unsafeRun(that.scope)
+	}).as("Map5")
+}
+
+// Map6 computation
+func Map6[A, B, C, D, E, F, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], fn func(A, B, C, D, E, F) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap5(a, b, c, d, e, func(valA A, valB B, valC C, valD D, valE E) *IO[T] {
+			return FlatMap(f, func(valF F) *IO[T] {
+				return NewIO(fn(valA, valB, valC, valD, valE, valF))
+			})
+		}).unsafeRun(that.scope)
+	}).as("Map6")
+}
+
+// Map7 computation
+func Map7[A, B, C, D, E, F, G, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], fn func(A, B, C, D, E, F, G) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap6(a, b, c, d, e, f, func(valA A, valB B, valC C, valD D, valE E, valF F) *IO[T] {
+			return FlatMap(g, func(valG G) *IO[T] {
+				return NewIO(fn(valA, valB, valC, valD, valE, valF, valG))
+			})
+		}).unsafeRun(that.scope)
+	}).as("Map7")
+}
+
+// Map8 computation
+func Map8[A, B, C, D, E, F, G, H, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], fn func(A, B, C, D, E, F, G, H) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap7(a, b, c, d, e, f, g, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G) *IO[T] {
+			return FlatMap(h, func(valH H) *IO[T] {
+				return NewIO(fn(valA, valB, valC, valD, valE, valF, valG, valH))
+			})
+		}).unsafeRun(that.scope)
+	}).as("Map8")
+}
+
+// Map9 computation
+func Map9[A, B, C, D, E, F, G, H, I, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], fn func(A, B, C, D, E, F, G, H, I) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap8(a, b, c, d, e, f, g, h, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G, valH H) *IO[T] {
+			return FlatMap(i, func(valI I) *IO[T] {
+				return NewIO(fn(valA, valB, valC, valD, valE, valF, valG, valH, valI))
+			})
+		}).unsafeRun(that.scope)
+	}).as("Map9")
+}
+
+// Map10 computation
+func Map10[A, B, C, D, E, F, G, H, I, J, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], j *IO[J], fn func(A, B, C, D, E, F, G, H, I, J) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		return FlatMap9(a, b, c, d, e, f, g, h, i, func(valA A, valB B, valC C, valD D, valE E, valF F, valG G, valH H, valI I) *IO[T] {
+			return FlatMap(j, func(valJ J) *IO[T] {
+				return NewIO(fn(valA, valB, valC, valD, valE, valF, valG, valH, valI, valJ))
+			})
+		}).unsafeRun(that.scope)
+	}).as("Map10")
+}
+
+// Zip2 computation, values of IOs as a tuple
+func Zip2[A, B any](a *IO[A], b *IO[B]) *IO[*tuple.T2[A, B]] {
+	return Map2(a, b, tuple.Of2[A, B]).as("Zip2")
+}
+
+// Zip3 computation, values of IOs as a tuple
+func Zip3[A, B, C any](a *IO[A], b *IO[B], c *IO[C]) *IO[*tuple.T3[A, B, C]] {
+	return Map3(a, b, c, tuple.Of3[A, B, C]).as("Zip3")
+}
+
+// Zip4 computation, values of IOs as a tuple
+func Zip4[A, B, C, D any](a *IO[A], b *IO[B], c *IO[C], d *IO[D]) *IO[*tuple.T4[A, B, C, D]] {
+	return Map4(a, b, c, d, tuple.Of4[A, B, C, D]).as("Zip4")
+}
+
+// Zip5 computation, values of IOs as a tuple
+func Zip5[A, B, C, D, E any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E]) *IO[*tuple.T5[A, B, C, D, E]] {
+	return Map5(a, b, c, d, e, tuple.Of5[A, B, C, D, E]).as("Zip5")
+}
+
+// Zip6 computation, values of IOs as a tuple
+func Zip6[A, B, C, D, E, F any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F]) *IO[*tuple.T6[A, B, C, D, E, F]] {
+	return Map6(a, b, c, d, e, f, tuple.Of6[A, B, C, D, E, F]).as("Zip6")
+}
+
+// Zip7 computation, values of IOs as a tuple
+func Zip7[A, B, C, D, E, F, G any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G]) *IO[*tuple.T7[A, B, C, D, E, F, G]] {
+	return Map7(a, b, c, d, e, f, g, tuple.Of7[A, B, C, D, E, F, G]).as("Zip7")
+}
+
+// Zip8 computation, values of IOs as a tuple
+func Zip8[A, B, C, D, E, F, G, H any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H]) *IO[*tuple.T8[A, B, C, D, E, F, G, H]] {
+	return Map8(a, b, c, d, e, f, g, h, tuple.Of8[A, B, C, D, E, F, G, H]).as("Zip8")
+}
+
+// Zip9 computation, values of IOs as a tuple
+func Zip9[A, B, C, D, E, F, G, H, I any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I]) *IO[*tuple.T9[A, B, C, D, E, F, G, H, I]] {
+	return Map9(a, b, c, d, e, f, g, h, i, tuple.Of9[A, B, C, D, E, F, G, H, I]).as("Zip9")
+}
+
+// Zip10 computation, values of IOs as a tuple
+func Zip10[A, B, C, D, E, F, G, H, I, J any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], j *IO[J]) *IO[*tuple.T10[A, B, C, D, E, F, G, H, I, J]] {
+	return Map10(a, b, c, d, e, f, g, h, i, j, tuple.Of10[A, B, C, D, E, F, G, H, I, J]).as("Zip10")
+}
+
+// ParMap2 computation, run IOs concurrently and map your values. On
+// failure, the first failed IO in args order is the result
+func ParMap2[A, B, T any](a *IO[A], b *IO[B], fn func(A, B) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		var refA *IO[A]
+		var refB *IO[B]
+		par(
+			func() { refA = a.unsafeRun(that.scope) },
+			func() { refB = b.unsafeRun(that.scope) },
+		)
+		if refA.IsError() || refA.IsEmpty() {
+			return NewMaybeErrorIO[T](refA.Get())
+		}
+		if refB.IsError() || refB.IsEmpty() {
+			return NewMaybeErrorIO[T](refB.Get())
+		}
+		return NewIO(fn(refA.UnsafeGet(), refB.UnsafeGet()))
+	}).as("ParMap2")
+}
+
+// ParMap3 computation, run IOs concurrently and map your values. On
+// failure, the first failed IO in args order is the result
+func ParMap3[A, B, C, T any](a *IO[A], b *IO[B], c *IO[C], fn func(A, B, C) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		var refA *IO[A]
+		var refB *IO[B]
+		var refC *IO[C]
+		par(
+			func() { refA = a.unsafeRun(that.scope) },
+			func() { refB = b.unsafeRun(that.scope) },
+			func() { refC = c.unsafeRun(that.scope) },
+		)
+		if refA.IsError() || refA.IsEmpty() {
+			return NewMaybeErrorIO[T](refA.Get())
+		}
+		if refB.IsError() || refB.IsEmpty() {
+			return NewMaybeErrorIO[T](refB.Get())
+		}
+		if refC.IsError() || refC.IsEmpty() {
+			return NewMaybeErrorIO[T](refC.Get())
+		}
+		return NewIO(fn(refA.UnsafeGet(), refB.UnsafeGet(), refC.UnsafeGet()))
+	}).as("ParMap3")
+}
+
+// ParMap4 computation, run IOs concurrently and map your values. On
+// failure, the first failed IO in args order is the result
+func ParMap4[A, B, C, D, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], fn func(A, B, C, D) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		var refA *IO[A]
+		var refB *IO[B]
+		var refC *IO[C]
+		var refD *IO[D]
+		par(
+			func() { refA = a.unsafeRun(that.scope) },
+			func() { refB = b.unsafeRun(that.scope) },
+			func() { refC = c.unsafeRun(that.scope) },
+			func() { refD = d.unsafeRun(that.scope) },
+		)
+		if refA.IsError() || refA.IsEmpty() {
+			return NewMaybeErrorIO[T](refA.Get())
+		}
+		if refB.IsError() || refB.IsEmpty() {
+			return NewMaybeErrorIO[T](refB.Get())
+		}
+		if refC.IsError() || refC.IsEmpty() {
+			return NewMaybeErrorIO[T](refC.Get())
+		}
+		if refD.IsError() || refD.IsEmpty() {
+			return NewMaybeErrorIO[T](refD.Get())
+		}
+		return NewIO(fn(refA.UnsafeGet(), refB.UnsafeGet(), refC.UnsafeGet(), refD.UnsafeGet()))
+	}).as("ParMap4")
+}
+
+// ParMap5 computation, run IOs concurrently and map your values. On
+// failure, the first failed IO in args order is the result
+func ParMap5[A, B, C, D, E, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], fn func(A, B, C, D, E) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		var refA *IO[A]
+		var refB *IO[B]
+		var refC *IO[C]
+		var refD *IO[D]
+		var refE *IO[E]
+		par(
+			func() { refA = a.unsafeRun(that.scope) },
+			func() { refB = b.unsafeRun(that.scope) },
+			func() { refC = c.unsafeRun(that.scope) },
+			func() { refD = d.unsafeRun(that.scope) },
+			func() { refE = e.unsafeRun(that.scope) },
+		)
+		if refA.IsError() || refA.IsEmpty() {
+			return NewMaybeErrorIO[T](refA.Get())
+		}
+		if refB.IsError() || refB.IsEmpty() {
+			return NewMaybeErrorIO[T](refB.Get())
+		}
+		if refC.IsError() || refC.IsEmpty() {
+			return NewMaybeErrorIO[T](refC.Get())
+		}
+		if refD.IsError() || refD.IsEmpty() {
+			return NewMaybeErrorIO[T](refD.Get())
+		}
+		if refE.IsError() || refE.IsEmpty() {
+			return NewMaybeErrorIO[T](refE.Get())
+		}
+		return NewIO(fn(refA.UnsafeGet(), refB.UnsafeGet(), refC.UnsafeGet(), refD.UnsafeGet(), refE.UnsafeGet()))
+	}).as("ParMap5")
+}
+
+// ParMap6 computation, run IOs concurrently and map your values. On
+// failure, the first failed IO in args order is the result
+func ParMap6[A, B, C, D, E, F, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], fn func(A, B, C, D, E, F) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		var refA *IO[A]
+		var refB *IO[B]
+		var refC *IO[C]
+		var refD *IO[D]
+		var refE *IO[E]
+		var refF *IO[F]
+		par(
+			func() { refA = a.unsafeRun(that.scope) },
+			func() { refB = b.unsafeRun(that.scope) },
+			func() { refC = c.unsafeRun(that.scope) },
+			func() { refD = d.unsafeRun(that.scope) },
+			func() { refE = e.unsafeRun(that.scope) },
+			func() { refF = f.unsafeRun(that.scope) },
+		)
+		if refA.IsError() || refA.IsEmpty() {
+			return NewMaybeErrorIO[T](refA.Get())
+		}
+		if refB.IsError() || refB.IsEmpty() {
+			return NewMaybeErrorIO[T](refB.Get())
+		}
+		if refC.IsError() || refC.IsEmpty() {
+			return NewMaybeErrorIO[T](refC.Get())
+		}
+		if refD.IsError() || refD.IsEmpty() {
+			return NewMaybeErrorIO[T](refD.Get())
+		}
+		if refE.IsError() || refE.IsEmpty() {
+			return NewMaybeErrorIO[T](refE.Get())
+		}
+		if refF.IsError() || refF.IsEmpty() {
+			return NewMaybeErrorIO[T](refF.Get())
+		}
+		return NewIO(fn(refA.UnsafeGet(), refB.UnsafeGet(), refC.UnsafeGet(), refD.UnsafeGet(), refE.UnsafeGet(), refF.UnsafeGet()))
+	}).as("ParMap6")
+}
+
+// ParMap7 computation, run IOs concurrently and map your values. On
+// failure, the first failed IO in args order is the result
+func ParMap7[A, B, C, D, E, F, G, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], fn func(A, B, C, D, E, F, G) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		var refA *IO[A]
+		var refB *IO[B]
+		var refC *IO[C]
+		var refD *IO[D]
+		var refE *IO[E]
+		var refF *IO[F]
+		var refG *IO[G]
+		par(
+			func() { refA = a.unsafeRun(that.scope) },
+			func() { refB = b.unsafeRun(that.scope) },
+			func() { refC = c.unsafeRun(that.scope) },
+			func() { refD = d.unsafeRun(that.scope) },
+			func() { refE = e.unsafeRun(that.scope) },
+			func() { refF = f.unsafeRun(that.scope) },
+			func() { refG = g.unsafeRun(that.scope) },
+		)
+		if refA.IsError() || refA.IsEmpty() {
+			return NewMaybeErrorIO[T](refA.Get())
+		}
+		if refB.IsError() || refB.IsEmpty() {
+			return NewMaybeErrorIO[T](refB.Get())
+		}
+		if refC.IsError() || refC.IsEmpty() {
+			return NewMaybeErrorIO[T](refC.Get())
+		}
+		if refD.IsError() || refD.IsEmpty() {
+			return NewMaybeErrorIO[T](refD.Get())
+		}
+		if refE.IsError() || refE.IsEmpty() {
+			return NewMaybeErrorIO[T](refE.Get())
+		}
+		if refF.IsError() || refF.IsEmpty() {
+			return NewMaybeErrorIO[T](refF.Get())
+		}
+		if refG.IsError() || refG.IsEmpty() {
+			return NewMaybeErrorIO[T](refG.Get())
+		}
+		return NewIO(fn(refA.UnsafeGet(), refB.UnsafeGet(), refC.UnsafeGet(), refD.UnsafeGet(), refE.UnsafeGet(), refF.UnsafeGet(), refG.UnsafeGet()))
+	}).as("ParMap7")
+}
+
+// ParMap8 computation, run IOs concurrently and map your values. On
+// failure, the first failed IO in args order is the result
+func ParMap8[A, B, C, D, E, F, G, H, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], fn func(A, B, C, D, E, F, G, H) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		var refA *IO[A]
+		var refB *IO[B]
+		var refC *IO[C]
+		var refD *IO[D]
+		var refE *IO[E]
+		var refF *IO[F]
+		var refG *IO[G]
+		var refH *IO[H]
+		par(
+			func() { refA = a.unsafeRun(that.scope) },
+			func() { refB = b.unsafeRun(that.scope) },
+			func() { refC = c.unsafeRun(that.scope) },
+			func() { refD = d.unsafeRun(that.scope) },
+			func() { refE = e.unsafeRun(that.scope) },
+			func() { refF = f.unsafeRun(that.scope) },
+			func() { refG = g.unsafeRun(that.scope) },
+			func() { refH = h.unsafeRun(that.scope) },
+		)
+		if refA.IsError() || refA.IsEmpty() {
+			return NewMaybeErrorIO[T](refA.Get())
+		}
+		if refB.IsError() || refB.IsEmpty() {
+			return NewMaybeErrorIO[T](refB.Get())
+		}
+		if refC.IsError() || refC.IsEmpty() {
+			return NewMaybeErrorIO[T](refC.Get())
+		}
+		if refD.IsError() || refD.IsEmpty() {
+			return NewMaybeErrorIO[T](refD.Get())
+		}
+		if refE.IsError() || refE.IsEmpty() {
+			return NewMaybeErrorIO[T](refE.Get())
+		}
+		if refF.IsError() || refF.IsEmpty() {
+			return NewMaybeErrorIO[T](refF.Get())
+		}
+		if refG.IsError() || refG.IsEmpty() {
+			return NewMaybeErrorIO[T](refG.Get())
+		}
+		if refH.IsError() || refH.IsEmpty() {
+			return NewMaybeErrorIO[T](refH.Get())
+		}
+		return NewIO(fn(refA.UnsafeGet(), refB.UnsafeGet(), refC.UnsafeGet(), refD.UnsafeGet(), refE.UnsafeGet(), refF.UnsafeGet(), refG.UnsafeGet(), refH.UnsafeGet()))
+	}).as("ParMap8")
+}
+
+// ParMap9 computation, run IOs concurrently and map your values. On
+// failure, the first failed IO in args order is the result
+func ParMap9[A, B, C, D, E, F, G, H, I, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], fn func(A, B, C, D, E, F, G, H, I) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		var refA *IO[A]
+		var refB *IO[B]
+		var refC *IO[C]
+		var refD *IO[D]
+		var refE *IO[E]
+		var refF *IO[F]
+		var refG *IO[G]
+		var refH *IO[H]
+		var refI *IO[I]
+		par(
+			func() { refA = a.unsafeRun(that.scope) },
+			func() { refB = b.unsafeRun(that.scope) },
+			func() { refC = c.unsafeRun(that.scope) },
+			func() { refD = d.unsafeRun(that.scope) },
+			func() { refE = e.unsafeRun(that.scope) },
+			func() { refF = f.unsafeRun(that.scope) },
+			func() { refG = g.unsafeRun(that.scope) },
+			func() { refH = h.unsafeRun(that.scope) },
+			func() { refI = i.unsafeRun(that.scope) },
+		)
+		if refA.IsError() || refA.IsEmpty() {
+			return NewMaybeErrorIO[T](refA.Get())
+		}
+		if refB.IsError() || refB.IsEmpty() {
+			return NewMaybeErrorIO[T](refB.Get())
+		}
+		if refC.IsError() || refC.IsEmpty() {
+			return NewMaybeErrorIO[T](refC.Get())
+		}
+		if refD.IsError() || refD.IsEmpty() {
+			return NewMaybeErrorIO[T](refD.Get())
+		}
+		if refE.IsError() || refE.IsEmpty() {
+			return NewMaybeErrorIO[T](refE.Get())
+		}
+		if refF.IsError() || refF.IsEmpty() {
+			return NewMaybeErrorIO[T](refF.Get())
+		}
+		if refG.IsError() || refG.IsEmpty() {
+			return NewMaybeErrorIO[T](refG.Get())
+		}
+		if refH.IsError() || refH.IsEmpty() {
+			return NewMaybeErrorIO[T](refH.Get())
+		}
+		if refI.IsError() || refI.IsEmpty() {
+			return NewMaybeErrorIO[T](refI.Get())
+		}
+		return NewIO(fn(refA.UnsafeGet(), refB.UnsafeGet(), refC.UnsafeGet(), refD.UnsafeGet(), refE.UnsafeGet(), refF.UnsafeGet(), refG.UnsafeGet(), refH.UnsafeGet(), refI.UnsafeGet()))
+	}).as("ParMap9")
+}
+
+// ParMap10 computation, run IOs concurrently and map your values. On
+// failure, the first failed IO in args order is the result
+func ParMap10[A, B, C, D, E, F, G, H, I, J, T any](a *IO[A], b *IO[B], c *IO[C], d *IO[D], e *IO[E], f *IO[F], g *IO[G], h *IO[H], i *IO[I], j *IO[J], fn func(A, B, C, D, E, F, G, H, I, J) T) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		var refA *IO[A]
+		var refB *IO[B]
+		var refC *IO[C]
+		var refD *IO[D]
+		var refE *IO[E]
+		var refF *IO[F]
+		var refG *IO[G]
+		var refH *IO[H]
+		var refI *IO[I]
+		var refJ *IO[J]
+		par(
+			func() { refA = a.unsafeRun(that.scope) },
+			func() { refB = b.unsafeRun(that.scope) },
+			func() { refC = c.unsafeRun(that.scope) },
+			func() { refD = d.unsafeRun(that.scope) },
+			func() { refE = e.unsafeRun(that.scope) },
+			func() { refF = f.unsafeRun(that.scope) },
+			func() { refG = g.unsafeRun(that.scope) },
+			func() { refH = h.unsafeRun(that.scope) },
+			func() { refI = i.unsafeRun(that.scope) },
+			func() { refJ = j.unsafeRun(that.scope) },
+		)
+		if refA.IsError() || refA.IsEmpty() {
+			return NewMaybeErrorIO[T](refA.Get())
+		}
+		if refB.IsError() || refB.IsEmpty() {
+			return NewMaybeErrorIO[T](refB.Get())
+		}
+		if refC.IsError() || refC.IsEmpty() {
+			return NewMaybeErrorIO[T](refC.Get())
+		}
+		if refD.IsError() || refD.IsEmpty() {
+			return NewMaybeErrorIO[T](refD.Get())
+		}
+		if refE.IsError() || refE.IsEmpty() {
+			return NewMaybeErrorIO[T](refE.Get())
+		}
+		if refF.IsError() || refF.IsEmpty() {
+			return NewMaybeErrorIO[T](refF.Get())
+		}
+		if refG.IsError() || refG.IsEmpty() {
+			return NewMaybeErrorIO[T](refG.Get())
+		}
+		if refH.IsError() || refH.IsEmpty() {
+			return NewMaybeErrorIO[T](refH.Get())
+		}
+		if refI.IsError() || refI.IsEmpty() {
+			return NewMaybeErrorIO[T](refI.Get())
+		}
+		if refJ.IsError() || refJ.IsEmpty() {
+			return NewMaybeErrorIO[T](refJ.Get())
+		}
+		return NewIO(fn(refA.UnsafeGet(), refB.UnsafeGet(), refC.UnsafeGet(), refD.UnsafeGet(), refE.UnsafeGet(), refF.UnsafeGet(), refG.UnsafeGet(), refH.UnsafeGet(), refI.UnsafeGet(), refJ.UnsafeGet()))
+	}).as("ParMap10")
+}
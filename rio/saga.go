@@ -0,0 +1,90 @@
+package rio
+
+import (
+	"fmt"
+
+	"github.com/mobilemindtech/go-io/saga"
+	"github.com/mobilemindtech/go-io/types/unit"
+)
+
+// Saga compensations of completed saga steps. When the IO of RunSaga fails,
+// the compensations run in reverse order. Each RunSaga run has your own
+// compensation log, so a Saga can be run concurrently
+type Saga struct {
+	retry *saga.RetryPolicy
+}
+
+func NewSaga() *Saga {
+	return &Saga{retry: saga.NoRetry}
+}
+
+// WithRetry set compensations retry policy
+func (this *Saga) WithRetry(policy *saga.RetryPolicy) *Saga {
+	this.retry = policy
+	return this
+}
+
+// sagaRun compensation log of a RunSaga run, linked to the log of outer runs
+type sagaRun struct {
+	saga  *Saga
+	log   *saga.Log
+	outer *sagaRun
+}
+
+// lookupSaga compensation log of the RunSaga run of s, or nil
+func (this *scope) lookupSaga(s *Saga) *saga.Log {
+	if this == nil {
+		return nil
+	}
+	for run := this.sagas; run != nil; run = run.outer {
+		if run.saga == s {
+			return run.log
+		}
+	}
+	return nil
+}
+
+// withSaga scope with the compensation log of a RunSaga run of s
+func (this *scope) withSaga(s *Saga, log *saga.Log) *scope {
+	run := &scope{}
+	if this != nil {
+		*run = *this
+	}
+	run.sagas = &sagaRun{saga: s, log: log, outer: run.sagas}
+	return run
+}
+
+// SagaStep IO that register compensate with the action value when action
+// succeeds, on the compensation log of the RunSaga run of s. Outside RunSaga
+// no compensation is registered. The step name is the action name
+func SagaStep[A any](s *Saga, action *IO[A], compensate func(A) *IO[*unit.Unit]) *IO[A] {
+	return suspend(func(that *IO[A]) *IO[A] {
+		res := unsafeRunFrom(action, that.scope)
+		log := that.scope.lookupSaga(s)
+		if log != nil && res.IsOk() && res.Get().NonEmpty() {
+			value := res.Get().Get()
+			name := action.name
+			if name == "" {
+				name = fmt.Sprintf("step %v", log.Len()+1)
+			}
+			log.Add(name, func() error {
+				return UnsafeRun(compensate(value)).FailureOrNil()
+			})
+		}
+		return NewIOWithResult(res)
+	}).as("SagaStep")
+}
+
+// RunSaga run io with a new compensation log and, when it fails, the
+// compensations of the completed steps. The failure is a *saga.Error with the
+// cause and compensation errors
+func RunSaga[T any](s *Saga, io *IO[T]) *IO[T] {
+	return suspend(func(that *IO[T]) *IO[T] {
+		log := saga.NewLog().WithRetry(s.retry)
+		res := unsafeRunFrom(io, that.scope.withSaga(s, log))
+		if res.IsError() {
+			return NewErrorIO[T](log.Compensate(res.Failure()))
+		}
+		return NewIOWithResult(res)
+	}).as("RunSaga")
+}
@@ -0,0 +1,57 @@
+package rio
+
+import (
+	"context"
+
+	"github.com/mobilemindtech/go-io/metrics"
+	"github.com/mobilemindtech/go-io/trace"
+)
+
+// scope of the IOs run by a computation. It is created per run, so runs of
+// the same IO don't share it
+type scope struct {
+	span  trace.Span // parent span
+	name  string     // run name, the nearest IO named with As or the entry point IO
+	sagas *sagaRun   // compensation logs of the RunSaga runs in progress
+	ctx   context.Context
+}
+
+// context of the run, set by WithContext. Background when there is none
+func (this *scope) context() context.Context {
+	if this == nil || this.ctx == nil {
+		return context.Background()
+	}
+	return this.ctx
+}
+
+// withContext scope of the IOs run with ctx
+func (this *scope) withContext(ctx context.Context) *scope {
+	run := &scope{}
+	if this != nil {
+		*run = *this
+	}
+	run.ctx = ctx
+	return run
+}
+
+// inScope run copy of IO with the scope of the IOs run by computation. The IO
+// is shared by runs, so it is not changed
+func (this *IO[T]) inScope(parent *scope, span trace.Span) *IO[T] {
+	if parent == nil && !trace.Enabled() && !metrics.Enabled() {
+		return this
+	}
+	s := &scope{span: span, name: this.name}
+	if parent != nil {
+		if span == nil {
+			s.span = parent.span
+		}
+		if this.step || this.name == "" {
+			s.name = parent.name
+		}
+		s.sagas = parent.sagas
+		s.ctx = parent.ctx
+	}
+	run := *this
+	run.scope = s
+	return &run
+}
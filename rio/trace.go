@@ -0,0 +1,60 @@
+package rio
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/mobilemindtech/go-io/trace"
+)
+
+var rioPackage = reflect.TypeFor[RIOError]().PkgPath() + "."
+
+// callerOutside first caller frame outside rio package
+func callerOutside() (string, int) {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, rioPackage) {
+			return frame.File, frame.Line
+		}
+		if !more {
+			return "", 0
+		}
+	}
+}
+
+// startSpan span of named step as child of parent scope span, if tracing is enabled
+func (this *IO[T]) startSpan(parent *scope) trace.Span {
+	if this.name == "" || !trace.Enabled() {
+		return nil
+	}
+	var parentSpan trace.Span
+	if parent != nil {
+		parentSpan = parent.span
+	}
+	span := trace.Start(parentSpan, this.name)
+	span.SetAttribute(trace.AttrIOName, this.name)
+	span.SetAttribute(trace.AttrIOType, reflect.TypeFor[T]().String())
+	if this.filename != "" {
+		span.SetAttribute(trace.AttrFilepath, this.filename)
+		span.SetAttribute(trace.AttrLineno, this.line)
+	}
+	return span
+}
+
+func (this *IO[T]) endSpan(span trace.Span, res *IO[T]) {
+	if span == nil {
+		return
+	}
+	if res != nil {
+		if res.IsError() {
+			span.RecordError(res.value.Failure())
+		} else {
+			span.SetAttribute(trace.AttrEmpty, res.IsEmpty())
+		}
+	}
+	span.End()
+}
@@ -0,0 +1,119 @@
+package runtime
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/mobilemindtech/go-io/types"
+)
+
+// StateVar state variable written by a resource or IO
+type StateVar struct {
+	Name string
+	Type string
+}
+
+// AppDescription static description of an IOApp: IOs with effects, declaration
+// sites and state variables written and read
+type AppDescription struct {
+	Name      string
+	Type      string
+	Resources []*StateVar
+	IOs       []*types.IODescription
+	Warnings  []string
+}
+
+// Describer apps that can describe your flow
+type Describer interface {
+	Describe() *AppDescription
+}
+
+// Describe app flow without running it. IOs without name are described with
+// generated var names. Reads of types not written before are reported as warnings
+func (this *IOApp[T]) Describe() *AppDescription {
+	desc := &AppDescription{Name: this.name, Type: reflect.TypeFor[T]().String()}
+	written := map[string]bool{}
+
+	for _, r := range this.resources {
+		v := &StateVar{Name: r.GetVarName()}
+		if typed, ok := r.(interface{ IOType() reflect.Type }); ok {
+			v.Type = typed.IOType().String()
+			written[v.Type] = true
+		}
+		desc.Resources = append(desc.Resources, v)
+	}
+
+	for i, runnable := range this.stack {
+		ioDesc := &types.IODescription{Name: runnable.GetVarName(), Type: runnable.IOType().String()}
+		if describer, ok := runnable.(types.IODescriber); ok {
+			ioDesc = describer.Describe()
+		}
+		if ioDesc.Name == "" {
+			ioDesc.Name = fmt.Sprintf("__var__%v", i)
+		}
+		for _, read := range ioDesc.Reads {
+			if !written[read] && read != stateType {
+				desc.Warnings = append(desc.Warnings,
+					fmt.Sprintf("IO %v reads %v that is not written before", ioDesc.Name, read))
+			}
+		}
+		desc.Warnings = append(desc.Warnings, ioDesc.Errors...)
+		written[ioDesc.Type] = true
+		desc.IOs = append(desc.IOs, ioDesc)
+	}
+	return desc
+}
+
+var stateType = reflect.TypeFor[*state.State]().String()
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Describer{}
+)
+
+// Register app by name, to be rendered by goio command
+func Register(name string, app Describer) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = app
+}
+
+// Registered names of registered apps
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	var names []string
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Render graph of registered app. Format should be dot or mermaid
+func Render(w io.Writer, name string, format string) error {
+	registryMu.RLock()
+	app, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return fmt.Errorf("app %v is not registered, registered apps: %v", name, Registered())
+	}
+	desc := app.Describe()
+	if desc.Name == "" {
+		desc.Name = name
+	}
+	switch format {
+	case "dot":
+		_, err := io.WriteString(w, desc.DOT())
+		return err
+	case "mermaid":
+		_, err := io.WriteString(w, desc.Mermaid())
+		return err
+	default:
+		return fmt.Errorf("unknown format %v, use dot or mermaid", format)
+	}
+}
@@ -0,0 +1,126 @@
+package runtime
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/mobilemindtech/go-io/types"
+)
+
+// DOT Graphviz graph of app. Each IO is a cluster of your effects, dashed
+// edges link state variables to effects that read them
+func (this *AppDescription) DOT() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %v {\n", dotQuote(this.Name))
+	b.WriteString("  rankdir=TB;\n  node [shape=box, fontname=\"monospace\"];\n")
+
+	for i, res := range this.Resources {
+		fmt.Fprintf(&b, "  res%v [label=%v, shape=cylinder];\n", i, dotQuote(varLabel(res.Name, res.Type)))
+	}
+
+	var prev string
+	for i, io := range this.IOs {
+		fmt.Fprintf(&b, "  subgraph cluster_%v {\n", i)
+		fmt.Fprintf(&b, "    label=%v;\n", dotQuote(varLabel(io.Name, io.Type)))
+		for j, eff := range io.Effects {
+			fmt.Fprintf(&b, "    %v [label=%v];\n", effectId(i, j), dotQuote(effectLabel(eff)))
+		}
+		b.WriteString("  }\n")
+		for j := range io.Effects {
+			curr := effectId(i, j)
+			if prev != "" {
+				fmt.Fprintf(&b, "  %v -> %v;\n", prev, curr)
+			}
+			prev = curr
+		}
+	}
+
+	this.readEdges(func(from string, to string, typ string) {
+		fmt.Fprintf(&b, "  %v -> %v [style=dashed, label=%v];\n", from, to, dotQuote(typ))
+	})
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid flowchart of app, with same layout of DOT
+func (this *AppDescription) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+
+	for i, res := range this.Resources {
+		fmt.Fprintf(&b, "  res%v[(%v)]\n", i, mermaidQuote(varLabel(res.Name, res.Type)))
+	}
+
+	var prev string
+	for i, io := range this.IOs {
+		fmt.Fprintf(&b, "  subgraph io%v[%v]\n", i, mermaidQuote(varLabel(io.Name, io.Type)))
+		for j, eff := range io.Effects {
+			fmt.Fprintf(&b, "    %v[%v]\n", effectId(i, j), mermaidQuote(effectLabel(eff)))
+		}
+		b.WriteString("  end\n")
+		for j := range io.Effects {
+			curr := effectId(i, j)
+			if prev != "" {
+				fmt.Fprintf(&b, "  %v --> %v\n", prev, curr)
+			}
+			prev = curr
+		}
+	}
+
+	this.readEdges(func(from string, to string, typ string) {
+		fmt.Fprintf(&b, "  %v -.->|%v| %v\n", from, mermaidQuote(typ), to)
+	})
+
+	return b.String()
+}
+
+// readEdges call f for each state read, from the last writer of read type to reader effect
+func (this *AppDescription) readEdges(f func(from string, to string, typ string)) {
+	writers := map[string]string{}
+	for i, res := range this.Resources {
+		if res.Type != "" {
+			writers[res.Type] = fmt.Sprintf("res%v", i)
+		}
+	}
+	for i, io := range this.IOs {
+		for j, eff := range io.Effects {
+			for _, read := range eff.Reads {
+				if writer, ok := writers[read]; ok {
+					f(writer, effectId(i, j), read)
+				}
+			}
+		}
+		if n := len(io.Effects); n > 0 {
+			writers[io.Type] = effectId(i, n-1)
+		}
+	}
+}
+
+func effectId(io int, eff int) string {
+	return fmt.Sprintf("io%v_%v", io, eff)
+}
+
+func varLabel(name string, typ string) string {
+	if typ == "" {
+		return name
+	}
+	return fmt.Sprintf("%v: %v", name, typ)
+}
+
+func effectLabel(eff *types.EffectDescription) string {
+	label := fmt.Sprintf("%v\n%v => %v", eff.Name, eff.TypeIn, eff.TypeOut)
+	if eff.Filename != "" {
+		label += fmt.Sprintf("\n%v:%v", filepath.Base(eff.Filename), eff.Line)
+	}
+	return label
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.NewReplacer(`"`, "#quot;", "\n", "<br/>").Replace(s) + `"`
+}
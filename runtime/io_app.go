@@ -2,14 +2,20 @@ package runtime
 
 import (
 	"fmt"
+	"github.com/mobilemindtech/go-io/checkpoint"
+	"github.com/mobilemindtech/go-io/logging"
+	"github.com/mobilemindtech/go-io/metrics"
 	"github.com/mobilemindtech/go-io/option"
 	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/saga"
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types"
+	"github.com/mobilemindtech/go-io/types/unit"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
+	"log/slog"
 	"reflect"
 	"runtime/debug"
+	"time"
 )
 
 type IOApp[T any] struct {
@@ -20,12 +26,23 @@ type IOApp[T any] struct {
 	_debug         bool
 	showStackTrace bool
 	fnCatch        func(error) *result.Result[*option.Option[T]]
+	name           string
+	logger         *slog.Logger
+	runLogger      *slog.Logger
+	flowErrors     []types.FlowError
+	checkpointer   *checkpoint.Checkpointer
+	runID          string
+	compensations  map[int]func(*state.State) error
+	saga           *saga.Log
 }
 
+const metricsRuntime = "io_app"
+
 func NewWithState[T any](state *state.State, effects ...types.IORunnable) *IOApp[T] {
 	app := &IOApp[T]{
 		stack: []types.IORunnable{},
 		state: state,
+		saga:  saga.NewLog(),
 	}
 	return app.Effects(effects...)
 }
@@ -38,6 +55,18 @@ func (this *IOApp[T]) Debug() *IOApp[T] {
 	return this
 }
 
+// As name app, used as metrics label
+func (this *IOApp[T]) As(name string) *IOApp[T] {
+	this.name = name
+	return this
+}
+
+// WithLogger set app logger. By default the library logger is used
+func (this *IOApp[T]) WithLogger(logger *slog.Logger) *IOApp[T] {
+	this.logger = logger
+	return this
+}
+
 func (this *IOApp[T]) ShowStackTrace() *IOApp[T] {
 	this.showStackTrace = true
 	return this
@@ -80,12 +109,24 @@ func (this *IOApp[T]) Effect(effect types.IORunnable) *IOApp[T] {
 	/*if suspended, ok := effect.(types.IIOSuspended); ok {
 		this.Suspended(suspended)
 	} else {*/
-	effect.CheckTypesFlow()
+	if validator, ok := effect.(types.IOFlowValidator); ok {
+		this.flowErrors = append(this.flowErrors, validator.ValidateFlow()...)
+	} else {
+		effect.CheckTypesFlow()
+	}
 	this.stack = append(this.stack, effect)
 
 	return this
 }
 
+// Validate app type flow. All effects type mismatches are returned as FlowErrors
+func (this *IOApp[T]) Validate() *result.Result[*IOApp[T]] {
+	if len(this.flowErrors) > 0 {
+		return result.OfError[*IOApp[T]](types.FlowErrors(this.flowErrors))
+	}
+	return result.OfValue(this)
+}
+
 func (this *IOApp[T]) Catch(f func(error) *result.Result[*option.Option[T]]) *IOApp[T] {
 	this.fnCatch = f
 	return this
@@ -103,6 +144,22 @@ func (this *IOApp[T]) Continue(effects ...types.IORunnable) *IOApp[T] {
 	return this.Effects(effects...)
 }
 
+// Step add effect with a compensation. When a later effect fails, the
+// compensations of completed steps run in reverse order, with the app state
+func (this *IOApp[T]) Step(effect types.IORunnable, compensate func(*state.State) error) *IOApp[T] {
+	if this.compensations == nil {
+		this.compensations = map[int]func(*state.State) error{}
+	}
+	this.compensations[len(this.stack)] = compensate
+	return this.Effect(effect)
+}
+
+// WithSagaRetry set compensations retry policy
+func (this *IOApp[T]) WithSagaRetry(policy *saga.RetryPolicy) *IOApp[T] {
+	this.saga.WithRetry(policy)
+	return this
+}
+
 func (this *IOApp[T]) Effects(effects ...types.IORunnable) *IOApp[T] {
 	for _, eff := range effects {
 		this.Effect(eff)
@@ -121,9 +178,29 @@ func (this *IOApp[T]) Suspended(suspended types.IIOSuspended) *IOApp[T] {
 }*/
 
 func (this *IOApp[T]) UnsafeRun() *result.Result[*option.Option[T]] {
+	if this.checkpointer != nil && this.runID == "" {
+		this.runID = logging.NewRunId()
+	}
+	return this.run(nil)
+}
+
+// run effects, from the snapshot step if any. Each completed effect is saved on checkpointer
+func (this *IOApp[T]) run(snapshot *checkpoint.Snapshot) *result.Result[*option.Option[T]] {
 
 	//var resultIO types.ResultOptionAny
+	if len(this.flowErrors) > 0 {
+		this.value = result.OfError[*option.Option[T]](types.FlowErrors(this.flowErrors))
+		return this.value
+	}
+
 	this.value = result.OfValue(option.None[T]())
+	start := time.Now()
+	runID := this.runID
+	if runID == "" {
+		runID = logging.NewRunId()
+	}
+	this.runLogger = logging.Or(this.logger).With(
+		logging.KeyApp, this.name, logging.KeyRunId, runID)
 
 	for _, r := range this.resources {
 		res := r.Open()
@@ -143,12 +220,41 @@ func (this *IOApp[T]) UnsafeRun() *result.Result[*option.Option[T]] {
 		this.state.SetVar(varName, res.Get())
 	}
 
-	resultIO, _ := this.stackRun(this.stack)
+	from := 0
+	var resultIO types.ResultOptionAny
+	var lastEffect types.IOEffect
+	this.saga.Reset()
+	if snapshot != nil {
+		for key, val := range snapshot.State.Items() {
+			this.state.SetVar(key, val)
+		}
+		from = snapshot.Step
+		resultIO = result.OfValue(snapshot.Last)
+		lastEffect = &resumedEffect{value: resultIO}
+		for i := 0; i < from; i++ {
+			this.addCompensation(i)
+		}
+	}
+
+	if from < len(this.stack) {
+		resultIO, _ = this.stackRun(this.stack, from, lastEffect)
+	}
+
+	if resultIO.IsError() && this.saga.Len() > 0 {
+		resultIO = result.OfError[*option.Option[any]](this.saga.Compensate(resultIO.Failure()))
+	}
+
+	if this.checkpointer != nil && resultIO.IsOk() {
+		if err := this.checkpointer.Delete(this.runID); err != nil {
+			this.runLogger.Warn("checkpoint delete failed", logging.KeyError, err)
+		}
+	}
 
 	if resultIO.IsError() {
 
 		if this.showStackTrace {
-			debug.PrintStack()
+			this.runLogger.Error("run failed",
+				logging.KeyError, resultIO.Failure(), logging.KeyStack, string(debug.Stack()))
 			this.showStackTrace = false // only first error
 		}
 
@@ -181,12 +287,21 @@ func (this *IOApp[T]) UnsafeRun() *result.Result[*option.Option[T]] {
 			})
 	}
 
+	metrics.ObserveRun(metricsRuntime, this.name, start,
+		this.value.FailureOrNil(), this.value.IsOk() && this.value.Get().IsEmpty())
+
 	return this.value
 }
 
-func (this *IOApp[T]) stackRun(ios []types.IORunnable) (resultIO types.ResultOptionAny, lastEffect types.IOEffect) {
+// stackRun run ios from a step. lastEffect is the effect before it, rebuilt
+// from the snapshot on resume
+func (this *IOApp[T]) stackRun(ios []types.IORunnable, from int, lastEffect types.IOEffect) (types.ResultOptionAny, types.IOEffect) {
+
+	var resultIO types.ResultOptionAny
+	failed := false
 
-	for _, io := range ios {
+	for i := from; i < len(ios); i++ {
+		io := ios[i]
 
 		/*
 			suspended := io.GetSuspended()
@@ -197,12 +312,17 @@ func (this *IOApp[T]) stackRun(ios []types.IORunnable) (resultIO types.ResultOpt
 
 		io.SetState(this.state)
 
+		if lg, ok := io.(types.IOLoggable); ok {
+			lg.SetLogger(this.runLogger)
+		}
+
 		if this._debug {
 			io.SetDebug(this._debug)
 		}
 
 		io.SetPrevEffect(lastEffect)
 		varName := io.GetVarName()
+		start := time.Now()
 		resultIO = io.UnsafeRunIO()
 		lastEffect = io.GetLastEffect()
 
@@ -210,8 +330,10 @@ func (this *IOApp[T]) stackRun(ios []types.IORunnable) (resultIO types.ResultOpt
 			varName = fmt.Sprintf("__var__%v", this.state.Count())
 		}
 
+		metrics.ObserveStep(metricsRuntime, this.name, varName, start)
+
 		if this._debug {
-			log.Printf("var = %v, IO result = %v", varName, resultIO.String())
+			this.runLogger.Info("step", "var", varName, "result", resultIO.String())
 		}
 
 		if resultIO.IsOk() && resultIO.Get().NonEmpty() {
@@ -219,7 +341,117 @@ func (this *IOApp[T]) stackRun(ios []types.IORunnable) (resultIO types.ResultOpt
 		} else {
 			//break
 		}
+
+		failed = failed || resultIO.IsError()
+		if !failed {
+			this.addCompensation(i)
+		}
+		if this.checkpointer != nil && !failed {
+			if err := this.checkpoint(i+1, resultIO); err != nil {
+				return result.OfError[*option.Option[any]](err), lastEffect
+			}
+		}
 	}
 
 	return resultIO, lastEffect
 }
+
+// WithCheckpoint save state after each completed effect, so the run can be
+// resumed. Resources vars are not saved, they are open again on resume
+func (this *IOApp[T]) WithCheckpoint(checkpointer *checkpoint.Checkpointer) *IOApp[T] {
+	this.checkpointer = checkpointer
+	return this
+}
+
+// WithRunID set run id of checkpoints. By default a new id is used on each run
+func (this *IOApp[T]) WithRunID(runID string) *IOApp[T] {
+	this.runID = runID
+	return this
+}
+
+// RunID checkpoints run id
+func (this *IOApp[T]) RunID() string {
+	return this.runID
+}
+
+// Resume run from the last completed effect of runID checkpoint, with the
+// saved state. Without checkpoint the app runs from the first effect
+func (this *IOApp[T]) Resume(runID string) *result.Result[*option.Option[T]] {
+	this.runID = runID
+	if this.checkpointer == nil {
+		return this.run(nil)
+	}
+
+	res := this.checkpointer.Load(runID)
+	if res.IsError() {
+		this.value = result.OfError[*option.Option[T]](res.Failure())
+		return this.value
+	}
+	return this.run(res.Get().OrNil())
+}
+
+// addCompensation register compensation of completed effect i, if any
+func (this *IOApp[T]) addCompensation(i int) {
+	compensate, ok := this.compensations[i]
+	if !ok {
+		return
+	}
+	name := this.stack[i].GetVarName()
+	if name == "" {
+		name = fmt.Sprintf("step %v", i+1)
+	}
+	this.saga.Add(name, func() error {
+		return compensate(this.state)
+	})
+}
+
+func (this *IOApp[T]) checkpoint(step int, resultIO types.ResultOptionAny) error {
+	skip := make([]string, len(this.resources))
+	for i, r := range this.resources {
+		skip[i] = r.GetVarName()
+	}
+	return this.checkpointer.Save(this.runID, step, this.state, resultIO.Get().OrNil(), skip...)
+}
+
+// resumedEffect last result of a resumed run, the previous effect of the first
+// effect that runs
+type resumedEffect struct {
+	value types.ResultOptionAny
+}
+
+func (this *resumedEffect) GetPrevEffect() *option.Option[types.IOEffect] {
+	return option.None[types.IOEffect]()
+}
+
+func (this *resumedEffect) SetPrevEffect(types.IOEffect) {}
+
+func (this *resumedEffect) GetResult() types.ResultOptionAny {
+	return this.value
+}
+
+func (this *resumedEffect) UnsafeRun() types.IOEffect {
+	return this
+}
+
+func (this *resumedEffect) SetDebug(bool) {}
+
+func (this *resumedEffect) String() string {
+	return fmt.Sprintf("Resumed(%v)", this.value.String())
+}
+
+func (this *resumedEffect) TypeIn() reflect.Type {
+	return reflect.TypeFor[*unit.Unit]()
+}
+
+func (this *resumedEffect) TypeOut() reflect.Type {
+	if this.value.IsOk() && this.value.Get().NonEmpty() {
+		return reflect.TypeOf(this.value.Get().Get())
+	}
+	return reflect.TypeFor[*unit.Unit]()
+}
+
+func (this *resumedEffect) SetDebugInfo(*types.IODebugInfo) {}
+
+func (this *resumedEffect) GetDebugInfo() *types.IODebugInfo {
+	return nil
+}
@@ -0,0 +1,160 @@
+// Package saga run compensations of completed steps when a multi step
+// computation fails. Compensations run in reverse order, with retries, and
+// all failures are reported on Error
+package saga
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RetryPolicy attempts and delay between attempts of a compensation
+type RetryPolicy struct {
+	attempts   int
+	delay      time.Duration
+	multiplier float64
+}
+
+// NewRetryPolicy policy of attempts tries, waiting delay between them
+func NewRetryPolicy(attempts int, delay time.Duration) *RetryPolicy {
+	return &RetryPolicy{attempts: max(attempts, 1), delay: delay, multiplier: 1}
+}
+
+// NoRetry run compensations once
+var NoRetry = NewRetryPolicy(1, 0)
+
+// WithBackoff multiply delay after each attempt
+func (this *RetryPolicy) WithBackoff(multiplier float64) *RetryPolicy {
+	this.multiplier = multiplier
+	return this
+}
+
+// Do run f until it succeeds or attempts are exhausted. Panics are attempts
+// that failed. Return the attempts count and the last error
+func (this *RetryPolicy) Do(f func() error) (attempts int, err error) {
+	delay := this.delay
+	for attempts = 1; ; attempts++ {
+		if err = try(f); err == nil || attempts >= this.attempts {
+			return
+		}
+		time.Sleep(delay)
+		delay = time.Duration(float64(delay) * this.multiplier)
+	}
+}
+
+func try(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return f()
+}
+
+// CompensationError compensation that failed all attempts
+type CompensationError struct {
+	Step     string
+	Attempts int
+	Err      error
+}
+
+func (this *CompensationError) Error() string {
+	return fmt.Sprintf("compensation of %v failed after %v attempts: %v", this.Step, this.Attempts, this.Err)
+}
+
+func (this *CompensationError) Unwrap() error {
+	return this.Err
+}
+
+// Error saga failure. Compensated are steps undone, in compensation order
+type Error struct {
+	Cause       error
+	Compensated []string
+	Failures    []*CompensationError
+}
+
+func (this *Error) Error() string {
+	msg := fmt.Sprintf("saga failed: %v", this.Cause)
+	if len(this.Failures) > 0 {
+		msgs := make([]string, len(this.Failures))
+		for i, f := range this.Failures {
+			msgs[i] = f.Error()
+		}
+		msg = fmt.Sprintf("%v; %v", msg, strings.Join(msgs, "; "))
+	}
+	return msg
+}
+
+// Unwrap cause and compensation errors, for errors.Is and errors.As
+func (this *Error) Unwrap() []error {
+	errs := []error{this.Cause}
+	for _, f := range this.Failures {
+		errs = append(errs, f)
+	}
+	return errs
+}
+
+type step struct {
+	name       string
+	compensate func() error
+}
+
+// Log compensations of completed steps
+type Log struct {
+	mu    sync.Mutex
+	steps []*step
+	retry *RetryPolicy
+}
+
+func NewLog() *Log {
+	return &Log{retry: NoRetry}
+}
+
+// WithRetry set compensations retry policy
+func (this *Log) WithRetry(policy *RetryPolicy) *Log {
+	this.retry = policy
+	return this
+}
+
+// Add compensation of a completed step
+func (this *Log) Add(name string, compensate func() error) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.steps = append(this.steps, &step{name: name, compensate: compensate})
+}
+
+// Len compensations count
+func (this *Log) Len() int {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return len(this.steps)
+}
+
+// Reset remove compensations, after the saga completes
+func (this *Log) Reset() {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.steps = nil
+}
+
+// Compensate run compensations in reverse order and remove them. Failed
+// compensations don't stop the others
+func (this *Log) Compensate(cause error) *Error {
+	this.mu.Lock()
+	steps := this.steps
+	this.steps = nil
+	this.mu.Unlock()
+
+	sagaErr := &Error{Cause: cause}
+	for i := len(steps) - 1; i >= 0; i-- {
+		s := steps[i]
+		if attempts, err := this.retry.Do(s.compensate); err != nil {
+			sagaErr.Failures = append(sagaErr.Failures, &CompensationError{Step: s.name, Attempts: attempts, Err: err})
+		} else {
+			sagaErr.Compensated = append(sagaErr.Compensated, s.name)
+		}
+	}
+	return sagaErr
+}
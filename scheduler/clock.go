@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock time source of the scheduler
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+// SystemClock clock of time package
+var SystemClock Clock = systemClock{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+type waiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// FakeClock clock that only moves on Advance, for tests
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*waiter
+}
+
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (this *FakeClock) Now() time.Time {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return this.now
+}
+
+func (this *FakeClock) After(d time.Duration) <-chan time.Time {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	w := &waiter{at: this.now.Add(d), ch: make(chan time.Time, 1)}
+	if d <= 0 {
+		w.ch <- this.now
+		return w.ch
+	}
+	this.waiters = append(this.waiters, w)
+	return w.ch
+}
+
+// Advance move clock and fire the waiters that are due, in time order
+func (this *FakeClock) Advance(d time.Duration) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.now = this.now.Add(d)
+	sort.SliceStable(this.waiters, func(i, j int) bool {
+		return this.waiters[i].at.Before(this.waiters[j].at)
+	})
+	pending := this.waiters[:0]
+	for _, w := range this.waiters {
+		if w.at.After(this.now) {
+			pending = append(pending, w)
+		} else {
+			w.ch <- this.now
+		}
+	}
+	this.waiters = pending
+}
+
+// Waiters count of pending After calls
+func (this *FakeClock) Waiters() int {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return len(this.waiters)
+}
+
+// BlockUntil wait until n After calls are pending
+func (this *FakeClock) BlockUntil(n int) {
+	for this.Waiters() < n {
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,227 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule next run time after a time. A zero time means no more runs
+type Schedule interface {
+	Next(after time.Time) time.Time
+}
+
+type every struct {
+	interval time.Duration
+}
+
+// Every schedule of fixed interval
+func Every(interval time.Duration) Schedule {
+	if interval <= 0 {
+		panic(fmt.Sprintf("scheduler interval should be > 0, but is %v", interval))
+	}
+	return &every{interval: interval}
+}
+
+func (this *every) Next(after time.Time) time.Time {
+	return after.Add(this.interval)
+}
+
+func (this *every) String() string {
+	return "@every " + this.interval.String()
+}
+
+// CronSchedule schedule of a standard cron expression
+type CronSchedule struct {
+	expr                     string
+	minute, hour, dom, month uint64
+	dow                      uint64
+	domStar, dowStar         bool
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Cron parse a cron expression of five fields: minute, hour, day of month,
+// month and day of week. Fields accept *, lists, ranges, steps and month and
+// week day names. Macros like @daily and @every 5m are also accepted.
+// When day of month and day of week are both restricted, a day matching
+// either runs, like cron
+func Cron(expr string) (Schedule, error) {
+	spec := strings.TrimSpace(expr)
+	if interval, ok := strings.CutPrefix(spec, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(interval))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("cron expression %q: invalid interval", expr)
+		}
+		return Every(d), nil
+	}
+	if macro, ok := macros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q: expected 5 fields, but has %v", expr, len(fields))
+	}
+
+	cron := &CronSchedule{expr: expr}
+	var err error
+	parsers := []struct {
+		f    field
+		dest *uint64
+	}{
+		{minuteField, &cron.minute},
+		{hourField, &cron.hour},
+		{domField, &cron.dom},
+		{monthField, &cron.month},
+		{dowField, &cron.dow},
+	}
+	for i, p := range parsers {
+		if *p.dest, err = p.f.parse(fields[i]); err != nil {
+			return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+		}
+	}
+	// 7 is also sunday
+	if cron.dow&(1<<7) != 0 {
+		cron.dow = cron.dow&^(1<<7) | 1
+	}
+	cron.domStar = strings.HasPrefix(fields[2], "*")
+	cron.dowStar = strings.HasPrefix(fields[4], "*")
+	return cron, nil
+}
+
+// MustCron parse cron expression or panic
+func MustCron(expr string) Schedule {
+	s, err := Cron(expr)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func (this field) parse(value string) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(value, ",") {
+		bits, err := this.parseItem(item)
+		if err != nil {
+			return 0, err
+		}
+		set |= bits
+	}
+	return set, nil
+}
+
+func (this field) parseItem(item string) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+	step := 1
+	if hasStep {
+		var err error
+		if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+			return 0, fmt.Errorf("%v: invalid step %q", this.name, stepPart)
+		}
+	}
+
+	start, end := this.min, this.max
+	if rangePart != "*" && rangePart != "?" {
+		from, to, isRange := strings.Cut(rangePart, "-")
+		var err error
+		if start, err = this.value(from); err != nil {
+			return 0, err
+		}
+		end = start
+		if isRange {
+			if end, err = this.value(to); err != nil {
+				return 0, err
+			}
+		} else if hasStep {
+			end = this.max
+		}
+		if start > end {
+			return 0, fmt.Errorf("%v: invalid range %q", this.name, rangePart)
+		}
+	}
+
+	var set uint64
+	for i := start; i <= end; i += step {
+		set |= 1 << i
+	}
+	return set, nil
+}
+
+func (this field) value(s string) (int, error) {
+	if v, ok := this.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < this.min || v > this.max {
+		return 0, fmt.Errorf("%v: invalid value %q, expected %v-%v", this.name, s, this.min, this.max)
+	}
+	return v, nil
+}
+
+// Next first minute after a time that matches the expression, on the time location
+func (this *CronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + 5
+
+	for t.Year() <= limit {
+		switch {
+		case !has(this.month, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !this.dayMatch(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !has(this.hour, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !has(this.minute, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (this *CronSchedule) dayMatch(t time.Time) bool {
+	dom := has(this.dom, t.Day())
+	dow := has(this.dow, int(t.Weekday()))
+	if this.domStar || this.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func (this *CronSchedule) String() string {
+	return this.expr
+}
+
+func has(set uint64, i int) bool {
+	return set&(1<<i) != 0
+}
@@ -0,0 +1,233 @@
+// Package scheduler run rio jobs on cron expressions and fixed intervals
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/mobilemindtech/go-io/logging"
+	"github.com/mobilemindtech/go-io/metrics"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/mobilemindtech/go-io/types/unit"
+)
+
+const metricsRuntime = "scheduler"
+
+// Overlap what to do when a job is due while the previous run is running
+type Overlap int
+
+const (
+	// Skip don't run
+	Skip Overlap = iota
+	// Queue run after the previous run completes
+	Queue
+	// Concurrent run in parallel
+	Concurrent
+)
+
+// Job IO that runs on a schedule
+type Job struct {
+	name     string
+	schedule Schedule
+	io       *rio.IO[*unit.Unit]
+	overlap  Overlap
+	jitter   time.Duration
+	recovers []func(error)
+
+	mu      sync.Mutex
+	running int
+	queued  int
+}
+
+// NewJob job of io. Runs that overlap are skipped by default
+func NewJob(name string, schedule Schedule, io *rio.IO[*unit.Unit]) *Job {
+	return &Job{name: name, schedule: schedule, io: io, overlap: Skip}
+}
+
+func (this *Job) Name() string {
+	return this.name
+}
+
+// WithOverlap set overlap policy
+func (this *Job) WithOverlap(overlap Overlap) *Job {
+	this.overlap = overlap
+	return this
+}
+
+// WithJitter delay each run by a random duration up to jitter
+func (this *Job) WithJitter(jitter time.Duration) *Job {
+	this.jitter = jitter
+	return this
+}
+
+// Recover call f with the error of a failed run. Panics are errors
+func (this *Job) Recover(f func(error)) *Job {
+	this.recovers = append(this.recovers, f)
+	return this
+}
+
+// Scheduler run jobs until Stop
+type Scheduler struct {
+	jobs    []*Job
+	clock   Clock
+	logger  *slog.Logger
+	mu      sync.Mutex
+	started bool
+	done    chan struct{}
+	stop    sync.Once
+	wg      sync.WaitGroup
+	// ctx of job runs, cancelled when Stop gives up waiting
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func New() *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{clock: SystemClock, done: make(chan struct{}), ctx: ctx, cancel: cancel}
+}
+
+// WithClock set clock, like a FakeClock on tests
+func (this *Scheduler) WithClock(clock Clock) *Scheduler {
+	this.clock = clock
+	return this
+}
+
+// WithLogger set scheduler logger. By default the library logger is used
+func (this *Scheduler) WithLogger(logger *slog.Logger) *Scheduler {
+	this.logger = logger
+	return this
+}
+
+// Add jobs. Jobs added after Start are scheduled at once
+func (this *Scheduler) Add(jobs ...*Job) *Scheduler {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.jobs = append(this.jobs, jobs...)
+	if this.started && !this.stopped() {
+		for _, job := range jobs {
+			this.wg.Go(func() { this.loop(job) })
+		}
+	}
+	return this
+}
+
+// Start schedule jobs
+func (this *Scheduler) Start() *Scheduler {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if this.started {
+		return this
+	}
+	this.started = true
+	for _, job := range this.jobs {
+		this.wg.Go(func() { this.loop(job) })
+	}
+	return this
+}
+
+// Stop scheduling and wait running jobs. Queued runs are dropped. If ctx is
+// done before jobs complete, the running jobs are cancelled, like an IO run
+// with rio.WithContext, and the ctx error is returned
+func (this *Scheduler) Stop(ctx context.Context) error {
+	this.stop.Do(func() { close(this.done) })
+
+	waited := make(chan struct{})
+	go func() {
+		this.wg.Wait()
+		close(waited)
+	}()
+
+	select {
+	case <-waited:
+		return nil
+	case <-ctx.Done():
+		this.cancel()
+		return ctx.Err()
+	}
+}
+
+func (this *Scheduler) stopped() bool {
+	select {
+	case <-this.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (this *Scheduler) loop(job *Job) {
+	for {
+		now := this.clock.Now()
+		next := job.schedule.Next(now)
+		if next.IsZero() {
+			return
+		}
+		select {
+		case <-this.done:
+			return
+		case <-this.clock.After(next.Sub(now)):
+			this.trigger(job)
+		}
+	}
+}
+
+// trigger start a job run, following the job overlap policy
+func (this *Scheduler) trigger(job *Job) {
+	job.mu.Lock()
+	defer job.mu.Unlock()
+
+	if job.running > 0 {
+		switch job.overlap {
+		case Skip:
+			logging.Or(this.logger).Debug("job skipped", logging.KeyIO, job.name)
+			return
+		case Queue:
+			job.queued++
+			return
+		}
+	}
+	job.running++
+	this.wg.Go(func() { this.run(job) })
+}
+
+// run job, and the queued runs
+func (this *Scheduler) run(job *Job) {
+	for {
+		if job.jitter > 0 {
+			select {
+			case <-this.done:
+			case <-this.clock.After(rand.N(job.jitter)):
+			}
+		}
+		if !this.stopped() {
+			this.exec(job)
+		}
+
+		job.mu.Lock()
+		if job.queued > 0 && !this.stopped() {
+			job.queued--
+			job.mu.Unlock()
+			continue
+		}
+		job.queued = 0
+		job.running--
+		job.mu.Unlock()
+		return
+	}
+}
+
+func (this *Scheduler) exec(job *Job) {
+	start := time.Now()
+	res := rio.UnsafeRun(rio.WithContext(this.ctx, job.io))
+	metrics.ObserveRun(metricsRuntime, job.name, start, res.FailureOrNil(), res.IsOk() && res.Get().IsEmpty())
+
+	if res.IsError() {
+		logging.Or(this.logger).Error("job failed", logging.KeyIO, job.name, logging.KeyError, res.Failure())
+		for _, f := range job.recovers {
+			f(res.Failure())
+		}
+	}
+}
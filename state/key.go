@@ -0,0 +1,76 @@
+package state
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Key typed state var. A key without name reads a var by type, like
+// reflective pipeline args, and writes a var with generated name
+type Key[T any] struct {
+	name string
+}
+
+func NewKey[T any](name string) Key[T] {
+	return Key[T]{name: name}
+}
+
+// TypeKey key of a var of type T
+func TypeKey[T any]() Key[T] {
+	return Key[T]{}
+}
+
+func (this Key[T]) Name() string {
+	return this.name
+}
+
+func (this Key[T]) String() string {
+	if this.name == "" {
+		return reflect.TypeFor[T]().String()
+	}
+	return this.name
+}
+
+// Get var value, or an error if var is not found or has other type
+func (this Key[T]) Get(st *State) (T, error) {
+	var val interface{}
+	var found bool
+	if this.name == "" {
+		val, found = lookupType(st, reflect.TypeFor[T]())
+	} else {
+		val, found = st.items[this.name]
+	}
+
+	if !found {
+		var zero T
+		return zero, fmt.Errorf("var %v not found on state", this)
+	}
+	if v, ok := val.(T); ok {
+		return v, nil
+	}
+	var zero T
+	return zero, fmt.Errorf("var %v type is %T, but expected %v", this, val, reflect.TypeFor[T]())
+}
+
+// Set var value and return var name
+func (this Key[T]) Set(st *State, value T) string {
+	name := this.name
+	if name == "" {
+		name = fmt.Sprintf("__var__%v", st.Count())
+	}
+	st.SetVar(name, value)
+	return name
+}
+
+func lookupType(st *State, typ reflect.Type) (interface{}, bool) {
+	for _, val := range st.items {
+		if val == nil {
+			continue
+		}
+		valType := reflect.TypeOf(val)
+		if valType == typ || typ.Kind() == reflect.Interface && valType.Implements(typ) {
+			return val, true
+		}
+	}
+	return nil, false
+}
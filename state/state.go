@@ -2,9 +2,9 @@ package state
 
 import (
 	"fmt"
+	"github.com/mobilemindtech/go-io/logging"
 	"github.com/mobilemindtech/go-io/option"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
 	"reflect"
 )
 
@@ -65,11 +65,11 @@ func (this *State) Copy() *State {
 }
 
 func (this *State) Dump() {
-	log.Printf("==> state dump start\n")
+	var attrs []any
 	for k, v := range this.items {
-		log.Printf("%v=%v\n", k, v)
+		attrs = append(attrs, k, v)
 	}
-	log.Printf("==> state dump end\n")
+	logging.Logger().Info("state dump", attrs...)
 }
 
 func ConsumeOf[T any](state *State, key string) T {
@@ -4,3 +4,30 @@ type Tuple struct {
 	Key string
 	Val interface{}
 }
+
+func NewTuple(key string, val interface{}) *Tuple {
+	return &Tuple{Key: key, Val: val}
+}
+
+// Tuples named values, set as many state vars when returned by a pipeline step
+type Tuples []*Tuple
+
+// Tupler values of a tuple, like tuple.T2. Pipeline steps that return a Tupler
+// set each value as a state var
+type Tupler interface {
+	Slice() []any
+}
+
+// Named tuple values as state tuples, in order. Values without name have an
+// empty key, so the pipeline generate your var name
+func Named(tp Tupler, names ...string) Tuples {
+	var tuples Tuples
+	for i, val := range tp.Slice() {
+		tuple := &Tuple{Val: val}
+		if i < len(names) {
+			tuple.Key = names[i]
+		}
+		tuples = append(tuples, tuple)
+	}
+	return tuples
+}
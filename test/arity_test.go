@@ -0,0 +1,87 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mobilemindtech/go-io/io"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/mobilemindtech/go-io/tuple"
+	"github.com/mobilemindtech/go-io/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRioZip(t *testing.T) {
+	zip := rio.Zip3(rio.Pure("Ana"), rio.Pure(30), rio.Pure(true))
+
+	res := rio.UnsafeRun(zip).Get().Get()
+	assert.Equal(t, tuple.Of3("Ana", 30, true), res)
+	assert.Equal(t, "(Ana, 30, true)", res.String())
+
+	failed := rio.Zip2(rio.Pure("Ana"), rio.Error[int](errors.New("fail")))
+	assert.Equal(t, "fail", rio.UnsafeRun(failed).GetError().Error())
+}
+
+func TestRioParMap(t *testing.T) {
+	var running, peak atomic.Int32
+	slow := func(v int) *rio.IO[int] {
+		return rio.PureF(func() int {
+			n := running.Add(1)
+			for m := peak.Load(); n > m && !peak.CompareAndSwap(m, n); m = peak.Load() {
+			}
+			time.Sleep(20 * time.Millisecond)
+			running.Add(-1)
+			return v
+		})
+	}
+
+	sum := rio.ParMap3(slow(1), slow(2), slow(3), func(a, b, c int) int { return a + b + c })
+	assert.Equal(t, 6, rio.UnsafeRun(sum).Get().Get())
+	assert.Greater(t, peak.Load(), int32(1))
+
+	failed := rio.ParMap2(rio.Error[int](errors.New("first")), rio.Error[int](errors.New("second")),
+		func(a, b int) int { return a + b })
+	assert.Equal(t, "first", rio.UnsafeRun(failed).GetError().Error())
+
+	empty := rio.ParMap2(rio.Pure(1), rio.NewEmptyIO[int](), func(a, b int) int { return a + b })
+	assert.True(t, rio.UnsafeRun(empty).Get().IsEmpty())
+}
+
+func TestRioMap10(t *testing.T) {
+	p := rio.Pure(1)
+	sum := rio.Map10(p, p, p, p, p, p, p, p, p, p,
+		func(a, b, c, d, e, f, g, h, i, j int) int { return a + b + c + d + e + f + g + h + i + j })
+	assert.Equal(t, 10, rio.UnsafeRun(sum).Get().Get())
+}
+
+func TestIOFlatMap10(t *testing.T) {
+	one := func() *types.IO[int] { return io.IO[int](io.PureVal(1)) }
+	r := io.IO[string](
+		io.FlatMap10(one(), one(), one(), one(), one(), one(), one(), one(), one(), one(),
+			func(a, b, c, d, e, f, g, h, i, j int) *types.IO[string] {
+				return io.IO[string](io.PureVal(fmt.Sprint(a + b + c + d + e + f + g + h + i + j)))
+			})).
+		UnsafeRun()
+
+	assert.Equal(t, option.Some("10"), r.Get())
+}
+
+func TestIOZip(t *testing.T) {
+	r := io.IO[*tuple.T2[string, int]](
+		io.Zip2(io.IO[string](io.PureVal("Ana")), io.IO[int](io.PureVal(30)))).
+		UnsafeRun()
+
+	assert.Equal(t, tuple.Of2("Ana", 30), r.Get().Get())
+}
+
+func TestTupled(t *testing.T) {
+	greet := tuple.Tupled2(func(name string, age int) string { return fmt.Sprintf("%v %v", name, age) })
+	assert.Equal(t, "Ana 30", greet(tuple.Of2("Ana", 30)))
+
+	name := rio.Map(rio.Zip2(rio.Pure("Ana"), rio.Pure(30)), greet)
+	assert.Equal(t, "Ana 30", rio.UnsafeRun(name).Get().Get())
+}
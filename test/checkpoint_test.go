@@ -0,0 +1,171 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/checkpoint"
+	"github.com/mobilemindtech/go-io/io"
+	"github.com/mobilemindtech/go-io/pipeline"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/runtime"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/stretchr/testify/assert"
+)
+
+type MigrationBatch struct {
+	Table string `json:"table"`
+	Rows  int    `json:"rows"`
+}
+
+func init() {
+	checkpoint.Register[*MigrationBatch]()
+}
+
+func migrationPipeline(calls *[]string, fail *bool) *pipeline.Pipeline[int] {
+	return pipeline.New[int]().
+		Next(func() *MigrationBatch {
+			*calls = append(*calls, "load")
+			return &MigrationBatch{Table: "users", Rows: 10}
+		}).
+		Next(func(b *MigrationBatch) int {
+			*calls = append(*calls, "copy")
+			return b.Rows * 2
+		}).
+		Next(func(rows int) (int, error) {
+			*calls = append(*calls, "index")
+			if *fail {
+				return 0, errors.New("index failed")
+			}
+			return rows + 1, nil
+		})
+}
+
+func TestPipelineCheckpointResume(t *testing.T) {
+	store := checkpoint.NewMemoryStore()
+	var calls []string
+	fail := true
+
+	res := migrationPipeline(&calls, &fail).
+		WithCheckpoint(checkpoint.New(store)).
+		WithRunID("nightly").
+		UnsafeRun()
+
+	assert.Equal(t, "index failed", res.Failure().Error())
+	cp := store.Load("nightly").Get().Get()
+	assert.Equal(t, 2, cp.Step)
+	assert.Equal(t, "*test.MigrationBatch", cp.Vars["__var__0"].Type)
+
+	fail = false
+	calls = nil
+	res = migrationPipeline(&calls, &fail).
+		WithCheckpoint(checkpoint.New(store)).
+		Resume("nightly")
+
+	assert.Equal(t, 21, res.Get().Get())
+	assert.Equal(t, []string{"index"}, calls)
+	assert.True(t, store.Load("nightly").Get().IsEmpty())
+}
+
+func TestPipelineResumeWithoutCheckpoint(t *testing.T) {
+	var calls []string
+	fail := false
+
+	res := migrationPipeline(&calls, &fail).
+		WithCheckpoint(checkpoint.New(checkpoint.NewMemoryStore())).
+		Resume("first-run")
+
+	assert.Equal(t, 21, res.Get().Get())
+	assert.Equal(t, []string{"load", "copy", "index"}, calls)
+}
+
+func TestCheckpointFileStore(t *testing.T) {
+	store := checkpoint.NewFileStore(t.TempDir())
+	cp := checkpoint.New(store)
+
+	st := state.NewState().
+		SetVar("batch", &MigrationBatch{Table: "orders", Rows: 3}).
+		SetVar("names", []string{"a", "b"})
+	assert.Nil(t, cp.Save("run-1", 2, st, 3))
+
+	snapshot := cp.Load("run-1").Get().Get()
+	assert.Equal(t, 2, snapshot.Step)
+	assert.Equal(t, &MigrationBatch{Table: "orders", Rows: 3}, snapshot.State.Var("batch"))
+	assert.Equal(t, []string{"a", "b"}, snapshot.State.Var("names"))
+	assert.Equal(t, 3, snapshot.Last.Get())
+
+	assert.Nil(t, cp.Delete("run-1"))
+	assert.True(t, cp.Load("run-1").Get().IsEmpty())
+	assert.Nil(t, cp.Delete("run-1"))
+
+	type unregistered struct{}
+	err := cp.Save("run-2", 1, state.NewState().SetVar("x", unregistered{}), nil)
+	assert.ErrorContains(t, err, "type test.unregistered is not registered")
+}
+
+func TestIOAppCheckpointResume(t *testing.T) {
+	store := checkpoint.NewMemoryStore()
+	var calls []string
+	fail := true
+
+	app := func() *runtime.IOApp[int] {
+		return io.IOApp[int]().
+			WithCheckpoint(checkpoint.New(store)).
+			Effects(
+				io.IO[int]().As("rows").
+					Pure(io.Pure(func() int {
+						calls = append(calls, "rows")
+						return 10
+					})),
+				io.IO[int]().As("total").
+					Attempt(io.AttemptState(func(st *state.State) *result.Result[int] {
+						calls = append(calls, "total")
+						if fail {
+							return result.OfError[int](errors.New("total failed"))
+						}
+						return result.OfValue(state.VarOf[int](st, "rows") * 3)
+					})),
+			)
+	}
+
+	res := app().WithRunID("nightly-app").UnsafeRun()
+	assert.Equal(t, "total failed", res.Failure().Error())
+	assert.Equal(t, 1, store.Load("nightly-app").Get().Get().Step)
+
+	fail = false
+	calls = nil
+	res = app().Resume("nightly-app")
+
+	assert.Equal(t, 30, res.Get().Get())
+	assert.Equal(t, []string{"total"}, calls)
+	assert.True(t, store.Load("nightly-app").Get().IsEmpty())
+}
+
+func TestIOAppResumeWithLastResult(t *testing.T) {
+	store := checkpoint.NewMemoryStore()
+	fail := true
+
+	app := func() *runtime.IOApp[int] {
+		return io.IOApp[int]().
+			WithCheckpoint(checkpoint.New(store)).
+			Effects(
+				io.IO[int](io.PureVal(10)),
+				io.IO[int](io.MaybeFail(func(i int) *result.Result[int] {
+					if fail {
+						return result.OfError[int](errors.New("failed once"))
+					}
+					return result.OfValue(i)
+				})),
+			)
+	}
+
+	res := app().WithRunID("last-result").UnsafeRun()
+	assert.Equal(t, "failed once", res.Failure().Error())
+
+	fail = false
+	res = app().Resume("last-result")
+	assert.Equal(t, 10, res.Get().Get())
+
+	fresh := app().UnsafeRun()
+	assert.Equal(t, 10, fresh.Get().Get())
+}
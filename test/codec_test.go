@@ -0,0 +1,103 @@
+package test
+
+import (
+	"io"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/codec"
+	"github.com/mobilemindtech/go-io/http"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/stretchr/testify/assert"
+)
+
+type Product struct {
+	Name   string            `xml:"name" csv:"name" form:"name" msgpack:"name"`
+	Price  float64           `xml:"price" csv:"price" form:"price" msgpack:"price"`
+	Stock  int               `xml:"stock" csv:"stock" form:"stock" msgpack:"stock"`
+	Tags   []string          `xml:"tag" csv:"-" form:"tag" msgpack:"tags"`
+	Attrs  map[string]uint16 `xml:"-" csv:"-" form:"-" msgpack:"attrs,omitempty"`
+	Active bool              `xml:"active" csv:"active" form:"active" msgpack:"active"`
+}
+
+func TestCodecRegistryLookup(t *testing.T) {
+	assert.Equal(t, codec.MimeJSON, codec.Lookup("application/json; charset=utf-8").Get().ContentType())
+	assert.Equal(t, codec.MimeJSON, codec.Lookup("application/problem+json").Get().ContentType())
+	assert.Equal(t, codec.MimeXML, codec.Lookup("text/xml").Get().ContentType())
+	assert.True(t, codec.Lookup("application/unknown").IsEmpty())
+}
+
+func TestCodecMsgPackRoundTrip(t *testing.T) {
+	p := &Product{Name: "coffee", Price: 12.5, Stock: -300, Tags: []string{"a", "b"},
+		Attrs: map[string]uint16{"size": 65535}, Active: true}
+
+	data, err := codec.MsgPackMarshal(p)
+	assert.Nil(t, err)
+
+	decoded := codec.NewDecoder[*Product](codec.NewMsgPackCodec()).Decode(data)
+	assert.True(t, decoded.IsOk())
+	assert.Equal(t, p, decoded.Get())
+
+	var generic map[string]any
+	assert.Nil(t, codec.MsgPackUnmarshal(data, &generic))
+	assert.Equal(t, "coffee", generic["name"])
+	assert.Equal(t, int64(-300), generic["stock"])
+
+	small, _ := codec.MsgPackMarshal(map[string]int{"a": 1})
+	assert.Equal(t, []byte{0x81, 0xa1, 'a', 0x01}, small)
+}
+
+func TestCodecCsv(t *testing.T) {
+	items := []*Product{{Name: "coffee", Price: 12.5, Stock: 3, Active: true}, {Name: "tea", Price: 4}}
+
+	data, err := codec.NewCsvCodec().Marshal(items)
+	assert.Nil(t, err)
+	assert.Equal(t, "name,price,stock,active\ncoffee,12.5,3,true\ntea,4,0,false\n", string(data))
+
+	decoded := codec.NewDecoder[[]*Product](codec.NewCsvCodec()).Decode(data)
+	assert.True(t, decoded.IsOk())
+	assert.Equal(t, items, decoded.Get())
+}
+
+func TestCodecForm(t *testing.T) {
+	data, err := codec.NewFormCodec().Marshal(&Product{Name: "coffee", Price: 1.5, Tags: []string{"x", "y"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "active=false&name=coffee&price=1.5&stock=0&tag=x&tag=y", string(data))
+
+	decoded := codec.NewDecoder[*Product](codec.NewFormCodec()).Decode(data)
+	assert.Equal(t, &Product{Name: "coffee", Price: 1.5, Tags: []string{"x", "y"}}, decoded.Get())
+}
+
+func TestHttpClientAutoDecodeXML(t *testing.T) {
+	requester := func(req *nethttp.Request) *result.Result[*http.Responser] {
+		return result.OfValue(&http.Responser{
+			StatusCode: 200,
+			Header:     nethttp.Header{"Content-Type": []string{"text/xml; charset=utf-8"}},
+			Body:       io.NopCloser(strings.NewReader("<Product><name>coffee</name><price>2</price></Product>")),
+			Raw:        option.None[*nethttp.Response](),
+		})
+	}
+
+	res := http.NewClient[any, *Product, any]().
+		AsJSON().
+		AutoDecode().
+		WithRequester(requester).
+		Get("http://localhost/product")
+
+	assert.True(t, res.IsOk())
+	assert.Equal(t, "coffee", res.Get().EntityBody.Get().Name)
+
+	var sentContentType string
+	res = http.NewClient[*Product, *Product, any]().
+		AsXML().
+		WithRequester(func(req *nethttp.Request) *result.Result[*http.Responser] {
+			sentContentType = req.Header.Get("Content-Type")
+			return requester(req)
+		}).
+		Post("http://localhost/product", &Product{Name: "tea"})
+
+	assert.Equal(t, codec.MimeXML, sentContentType)
+	assert.Equal(t, float64(2), res.Get().EntityBody.Get().Price)
+}
@@ -0,0 +1,163 @@
+package test
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/collections"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPersistent(t *testing.T) {
+	list := collections.ListOf(1, 2, 3)
+	prepended := list.Prepend(0)
+	appended := list.Append(4)
+
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	assert.Equal(t, []int{0, 1, 2, 3}, prepended.ToSlice())
+	assert.Equal(t, []int{1, 2, 3, 4}, appended.ToSlice())
+	assert.Equal(t, 1, list.Head().Get())
+	assert.Equal(t, []int{2, 3}, list.Tail().ToSlice())
+	assert.True(t, collections.EmptyList[int]().Head().IsEmpty())
+	assert.Equal(t, 3, list.Get(2).Get())
+	assert.Equal(t, []int{3, 2, 1}, list.Reverse().ToSlice())
+	assert.Equal(t, "List(1, 2, 3)", list.String())
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(collections.ListFromSeq(slices.Values([]int{1, 2, 3})).All()))
+}
+
+func TestListCombinators(t *testing.T) {
+	list := collections.ListOf(1, 2, 3, 4, 5, 6)
+
+	assert.Equal(t, []int{2, 4, 6}, list.Filter(func(i int) bool { return i%2 == 0 }).ToSlice())
+	assert.Equal(t, []string{"1", "2"}, collections.ListMap(collections.ListOf(1, 2), func(i int) string {
+		return string(rune('0' + i))
+	}).ToSlice())
+	assert.Equal(t, 21, collections.ListFold(list, 0, func(acc int, i int) int { return acc + i }))
+
+	even, odd := list.Partition(func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, []int{2, 4, 6}, even.ToSlice())
+	assert.Equal(t, []int{1, 3, 5}, odd.ToSlice())
+
+	groups := collections.ListGroupBy(list, func(i int) int { return i % 3 })
+	assert.Equal(t, 3, groups.Len())
+	assert.Equal(t, []int{1, 4}, groups.Get(1).Get().ToSlice())
+
+	flat := collections.ListFlatMap(collections.ListOf(1, 2), func(i int) *collections.List[int] {
+		return collections.ListOf(i, i*10)
+	})
+	assert.Equal(t, []int{1, 10, 2, 20}, flat.ToSlice())
+}
+
+func TestMapHAMT(t *testing.T) {
+	m := collections.EmptyMap[int, int]()
+	expected := map[int]int{}
+	for i := range 10000 {
+		m = m.Put(i, i*2)
+		expected[i] = i * 2
+	}
+	snapshot := m
+
+	for i := 0; i < 10000; i += 2 {
+		m = m.Remove(i)
+		delete(expected, i)
+	}
+	m = m.Put(1, -1)
+	expected[1] = -1
+
+	assert.Equal(t, len(expected), m.Len())
+	assert.Equal(t, expected, m.ToGo())
+	assert.Equal(t, 10000, snapshot.Len())
+	assert.Equal(t, 2, snapshot.Get(1).Get())
+	assert.True(t, m.Get(2).IsEmpty())
+	assert.Same(t, m, m.Remove(-10))
+
+	for m.NonEmpty() {
+		m = m.Remove(m.Keys()[0])
+	}
+	assert.Equal(t, 0, m.Len())
+	assert.Empty(t, m.ToGo())
+}
+
+func TestMapCombinators(t *testing.T) {
+	m := collections.MapFromGo(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	assert.Equal(t, map[string]int{"b": 2}, m.Filter(func(k string, v int) bool { return v%2 == 0 }).ToGo())
+	assert.Equal(t, map[string]string{"a": "1", "b": "2", "c": "3"},
+		collections.MapValues(m, func(v int) string { return string(rune('0' + v)) }).ToGo())
+	assert.Equal(t, 6, collections.MapFold(m, 0, func(acc int, _ string, v int) int { return acc + v }))
+
+	small, big := m.Partition(func(_ string, v int) bool { return v < 3 })
+	assert.Equal(t, 2, small.Len())
+	assert.Equal(t, 1, big.Len())
+
+	groups := collections.MapGroupBy(m, func(_ string, v int) bool { return v%2 == 0 })
+	assert.Equal(t, 2, groups.Get(false).Get().Len())
+
+	fromSeq := collections.MapFromSeq(maps.All(map[string]int{"x": 1}))
+	assert.Equal(t, 1, fromSeq.Get("x").Get())
+}
+
+func TestSet(t *testing.T) {
+	set := collections.SetOf(1, 2, 3, 3)
+	other := collections.SetOf(3, 4)
+
+	assert.Equal(t, 3, set.Len())
+	assert.True(t, set.Contains(2))
+	assert.False(t, set.Remove(2).Contains(2))
+	assert.True(t, set.Contains(2))
+
+	sorted := func(s *collections.Set[int]) []int {
+		items := s.ToSlice()
+		slices.Sort(items)
+		return items
+	}
+	assert.Equal(t, []int{1, 2, 3, 4}, sorted(set.Union(other)))
+	assert.Equal(t, []int{3}, sorted(set.Intersect(other)))
+	assert.Equal(t, []int{1, 2}, sorted(set.Diff(other)))
+	assert.Equal(t, []int{2, 4, 6}, sorted(collections.SetMap(set, func(i int) int { return i * 2 })))
+	assert.Equal(t, 6, collections.SetFold(set, 0, func(acc int, i int) int { return acc + i }))
+
+	odd, even := set.Partition(func(i int) bool { return i%2 == 1 })
+	assert.Equal(t, []int{1, 3}, sorted(odd))
+	assert.Equal(t, []int{2}, sorted(even))
+	assert.Equal(t, 2, collections.SetGroupBy(set, func(i int) bool { return i > 1 }).Get(true).Get().Len())
+}
+
+func TestTraverse(t *testing.T) {
+	list := collections.ListOf(1, 2, 3)
+
+	opt := collections.TraverseOption(list, func(i int) *option.Option[int] { return option.Some(i * 2) })
+	assert.Equal(t, []int{2, 4, 6}, opt.Get().ToSlice())
+	assert.True(t, collections.SequenceOption(collections.ListOf(option.Some(1), option.None[int]())).IsEmpty())
+
+	err := errors.New("fail")
+	res := collections.TraverseResult(list, func(i int) *result.Result[int] {
+		if i == 2 {
+			return result.OfError[int](err)
+		}
+		return result.OfValue(i)
+	})
+	assert.Equal(t, err, res.Failure())
+	assert.Equal(t, []int{1, 2}, collections.SequenceResult(
+		collections.ListOf(result.OfValue(1), result.OfValue(2))).Get().ToSlice())
+
+	calls := 0
+	io := collections.TraverseIO(list, func(i int) *rio.IO[string] {
+		return rio.PureF(func() string {
+			calls++
+			return string(rune('a' + i))
+		})
+	})
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, []string{"b", "c", "d"}, rio.UnsafeRun(io).Get().Get().ToSlice())
+
+	empty := collections.SequenceIO(collections.ListOf(rio.Pure(1), rio.FlatMap(rio.Pure(0), func(int) *rio.IO[int] {
+		return rio.Filter(rio.Pure(1), func(int) bool { return false })
+	})))
+	assert.True(t, rio.UnsafeRun(empty).Get().IsEmpty())
+}
@@ -0,0 +1,83 @@
+package test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/io"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/runtime"
+	"github.com/mobilemindtech/go-io/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func describeApp() *runtime.IOApp[string] {
+	return io.IOApp[string]().
+		As("greet").
+		Resource(&types.ResourceIO[*Person]{
+			VarName: "person",
+			OpenFn:  func() *result.Result[*Person] { return result.OfValue(&Person{Name: "Ana"}) },
+			CloseFn: func() *result.Result[*Person] { return result.OfValue[*Person](nil) },
+		}).
+		Effects(
+			io.IO[int]().As("count").
+				Pure(io.PureVal(2)).
+				Map(io.Map(func(i int) int { return i * 2 })),
+			io.IO[string]().As("message").
+				Pipe(io.PipeOfValue(func(p *Person) string { return "hi " + p.Name })),
+			io.IO[string]().
+				Pipe(io.PipeOfValue(func(f float64) string { return "" })),
+		)
+}
+
+func TestDescribeApp(t *testing.T) {
+	desc := describeApp().Describe()
+
+	assert.Equal(t, "greet", desc.Name)
+	assert.Equal(t, "string", desc.Type)
+	assert.Equal(t, "person", desc.Resources[0].Name)
+	assert.Equal(t, "*test.Person", desc.Resources[0].Type)
+	assert.Len(t, desc.IOs, 3)
+
+	count := desc.IOs[0]
+	assert.Equal(t, "count", count.Name)
+	assert.Equal(t, "int", count.Type)
+	assert.Equal(t, "IOPure", count.Effects[0].Name)
+	assert.Equal(t, "IOMap", count.Effects[1].Name)
+	assert.Equal(t, "int", count.Effects[1].TypeIn)
+	assert.True(t, strings.HasSuffix(count.Effects[1].Filename, "describe_test.go"))
+	assert.Greater(t, count.Effects[1].Line, 0)
+
+	assert.Equal(t, []string{"*test.Person"}, desc.IOs[1].Reads)
+	assert.Equal(t, "__var__2", desc.IOs[2].Name)
+	assert.Equal(t, []string{"IO __var__2 reads float64 that is not written before"}, desc.Warnings)
+}
+
+func TestDescribeGraph(t *testing.T) {
+	desc := describeApp().Describe()
+
+	dot := desc.DOT()
+	assert.True(t, strings.HasPrefix(dot, "digraph \"greet\" {"))
+	assert.Contains(t, dot, "res0 [label=\"person: *test.Person\", shape=cylinder];")
+	assert.Contains(t, dot, "io0_0 -> io0_1;")
+	assert.Contains(t, dot, "res0 -> io1_0 [style=dashed, label=\"*test.Person\"];")
+
+	mermaid := desc.Mermaid()
+	assert.True(t, strings.HasPrefix(mermaid, "flowchart TD\n"))
+	assert.Contains(t, mermaid, "subgraph io0[\"count: int\"]")
+	assert.Contains(t, mermaid, "res0 -.->|\"*test.Person\"| io1_0")
+}
+
+func TestDescribeRegistry(t *testing.T) {
+	runtime.Register("describe-test", describeApp())
+
+	assert.Contains(t, runtime.Registered(), "describe-test")
+
+	var buf bytes.Buffer
+	assert.Nil(t, runtime.Render(&buf, "describe-test", "mermaid"))
+	assert.Contains(t, buf.String(), "flowchart TD")
+
+	assert.NotNil(t, runtime.Render(&buf, "describe-test", "svg"))
+	assert.NotNil(t, runtime.Render(&buf, "unknown", "dot"))
+}
@@ -0,0 +1,90 @@
+package test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/either"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/stretchr/testify/assert"
+)
+
+type OutOfStock struct {
+	Sku string
+}
+
+func reserve(sku string, qty int) *either.Either[*OutOfStock, int] {
+	if qty > 10 {
+		return either.Left[*OutOfStock, int](&OutOfStock{Sku: sku})
+	}
+	return either.Right[*OutOfStock](qty)
+}
+
+func TestEitherCombinators(t *testing.T) {
+	right := reserve("a", 2)
+	left := reserve("b", 20)
+
+	assert.Equal(t, "Right(2)", right.String())
+	assert.Equal(t, "4", either.Map(right, func(i int) string { return strconv.Itoa(i * 2) }).Right())
+	assert.Equal(t, "b", either.MapLeft(left, func(e *OutOfStock) string { return e.Sku }).Left())
+	assert.Equal(t, 3, either.FlatMap(right, func(i int) *either.Either[*OutOfStock, int] {
+		return reserve("a", i+1)
+	}).Right())
+	assert.True(t, either.FlatMap(left, func(i int) *either.Either[*OutOfStock, int] {
+		return reserve("a", i)
+	}).IsLeft())
+
+	fold := func(e *either.Either[*OutOfStock, int]) string {
+		return either.Fold(e, func(l *OutOfStock) string { return "out " + l.Sku }, strconv.Itoa)
+	}
+	assert.Equal(t, "2", fold(right))
+	assert.Equal(t, "out b", fold(left))
+
+	assert.Equal(t, 2, right.Swap().Left())
+	assert.Equal(t, 5, left.RightOr(5))
+
+	bimap := either.Bimap(left, func(l *OutOfStock) string { return l.Sku }, func(r int) bool { return r > 0 })
+	assert.Equal(t, "b", bimap.Left())
+}
+
+func TestEitherTraverse(t *testing.T) {
+	all := either.Traverse([]int{1, 2, 3}, func(i int) *either.Either[*OutOfStock, int] {
+		return reserve("x", i)
+	})
+	assert.Equal(t, []int{1, 2, 3}, all.Right())
+
+	failed := either.Sequence([]*either.Either[*OutOfStock, int]{reserve("a", 1), reserve("b", 11), reserve("c", 12)})
+	assert.Equal(t, "b", failed.Left().Sku)
+}
+
+func TestEitherInterop(t *testing.T) {
+	err := errors.New("fail")
+	assert.Equal(t, err, either.FromResult(result.OfError[int](err)).Left())
+	assert.Equal(t, 1, either.FromResult(result.OfValue(1)).Right())
+
+	res := either.ToResult(reserve("b", 20))
+	var leftErr *either.LeftError[*OutOfStock]
+	assert.True(t, errors.As(res.Failure(), &leftErr))
+	assert.Equal(t, "b", leftErr.Value.Sku)
+	assert.Equal(t, 2, either.ToResult(reserve("a", 2)).Get())
+
+	assert.Equal(t, "missing", either.FromOption(option.None[int](), func() string { return "missing" }).Left())
+	assert.Equal(t, 1, either.FromOption(option.Some(1), func() string { return "missing" }).Right())
+}
+
+func TestEitherRIO(t *testing.T) {
+	assert.Equal(t, 2, rio.UnsafeRun(rio.FromEither(reserve("a", 2))).Get().Get())
+
+	res := rio.UnsafeRun(rio.Absolve(rio.PureF(func() *either.Either[*OutOfStock, int] {
+		return reserve("b", 20)
+	})))
+	assert.True(t, res.IsError())
+	assert.Equal(t, "left: &{b}", res.Failure().Error())
+
+	err := errors.New("fail")
+	res = rio.UnsafeRun(rio.FromEither(either.Left[error, int](err)))
+	assert.Equal(t, err, res.Failure())
+}
@@ -0,0 +1,131 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/either"
+	"github.com/mobilemindtech/go-io/json"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/stretchr/testify/assert"
+)
+
+type CustomerDTO struct {
+	Name     string                   `json:"name"`
+	Nickname *option.Option[string]   `json:"nickname"`
+	Age      option.Option[int]       `json:"age"`
+	Email    option.Option[string]    `json:"email,omitzero"`
+	Tags     *option.Option[[]string] `json:"tags,omitzero"`
+}
+
+func TestOptionJson(t *testing.T) {
+	dto := &CustomerDTO{
+		Name:     "Ana",
+		Nickname: option.Some("ani"),
+		Age:      *option.Some(30),
+		Email:    *option.None[string](),
+	}
+
+	data := json.Encode(dto).Get()
+	assert.JSONEq(t, `{"name":"Ana","nickname":"ani","age":30}`, string(data))
+
+	decoded := json.Decode[*CustomerDTO](data).Get()
+	assert.Equal(t, "ani", decoded.Nickname.Get())
+	assert.Equal(t, 30, decoded.Age.Get())
+	assert.True(t, decoded.Email.IsEmpty())
+	assert.True(t, decoded.Tags.IsEmpty())
+
+	decoded = json.Decode[*CustomerDTO]([]byte(`{"name":"Bia","nickname":null,"age":null}`)).Get()
+	assert.True(t, decoded.Nickname.IsEmpty())
+	assert.True(t, decoded.Age.IsEmpty())
+
+	data = json.Encode(&CustomerDTO{Name: "Bia"}).Get()
+	assert.JSONEq(t, `{"name":"Bia","nickname":null,"age":null}`, string(data))
+
+	res := json.Decode[*CustomerDTO]([]byte(`{"age":"x"}`))
+	assert.True(t, res.IsError())
+}
+
+type ProfileDTO struct {
+	Name     string                 `json:"name"`
+	Nickname *option.Option[string] `json:"nickname,omitempty"`
+	Age      option.Option[int]     `json:"age,omitempty"`
+	Score    int                    `json:"score"`
+	Friends  []*ProfileDTO          `json:"friends,omitempty"`
+}
+
+func TestOptionJsonOmitEmpty(t *testing.T) {
+	dto := &ProfileDTO{
+		Name:     "Ana",
+		Nickname: option.None[string](),
+		Age:      *option.None[int](),
+		Friends:  []*ProfileDTO{{Name: "Bia", Age: *option.Some(20)}},
+	}
+
+	data := json.Encode(dto).Get()
+	assert.Equal(t, `{"name":"Ana","score":0,"friends":[{"name":"Bia","age":20,"score":0}]}`, string(data))
+
+	data = json.Encode(map[string]ProfileDTO{"a": {Name: "Caio", Nickname: option.Some("c")}}).Get()
+	assert.Equal(t, `{"a":{"name":"Caio","nickname":"c","score":0}}`, string(data))
+}
+
+func TestOptionSql(t *testing.T) {
+	var opt option.Option[int]
+	assert.Nil(t, opt.Scan(int64(7)))
+	assert.Equal(t, 7, opt.Get())
+
+	assert.Nil(t, opt.Scan(nil))
+	assert.True(t, opt.IsEmpty())
+
+	var name option.Option[string]
+	assert.Nil(t, name.Scan([]byte("ana")))
+	assert.Equal(t, "ana", name.Get())
+
+	value, err := option.None[string]().Value()
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+
+	value, err = option.Some(5).Value()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), value)
+}
+
+func TestResultJson(t *testing.T) {
+	data := json.Encode(result.OfValue(10)).Get()
+	assert.JSONEq(t, `{"ok":true,"value":10}`, string(data))
+
+	data = json.Encode(result.OfError[int](errors.New("boom"))).Get()
+	assert.JSONEq(t, `{"ok":false,"error":"boom"}`, string(data))
+
+	ok := json.Decode[*result.Result[int]]([]byte(`{"ok":true,"value":10}`)).Get()
+	assert.Equal(t, 10, ok.Get())
+
+	fail := json.Decode[*result.Result[int]]([]byte(`{"ok":false,"error":"boom"}`)).Get()
+	assert.True(t, fail.IsError())
+	assert.Equal(t, "boom", fail.Failure().Error())
+
+	assert.True(t, json.Decode[*result.Result[int]]([]byte(`{}`)).IsError())
+	assert.True(t, json.Decode[*result.Result[int]]([]byte(`{"value":10}`)).IsError())
+}
+
+func TestEitherJson(t *testing.T) {
+	data := json.Encode(either.Right[*OutOfStock](2)).Get()
+	assert.JSONEq(t, `{"right":2}`, string(data))
+
+	data = json.Encode(either.Left[*OutOfStock, int](&OutOfStock{Sku: "a"})).Get()
+	assert.JSONEq(t, `{"left":{"Sku":"a"}}`, string(data))
+
+	left := json.Decode[*either.Either[*OutOfStock, int]](data).Get()
+	assert.Equal(t, "a", left.Left().Sku)
+
+	right := json.Decode[*either.Either[*OutOfStock, int]]([]byte(`{"right":3}`)).Get()
+	assert.Equal(t, 3, right.Right())
+
+	data = json.Encode(either.Left[error, int](errors.New("boom"))).Get()
+	assert.JSONEq(t, `{"left":"boom"}`, string(data))
+	assert.Equal(t, "boom", json.Decode[*either.Either[error, int]](data).Get().Left().Error())
+
+	invalid := json.Decode[*either.Either[*OutOfStock, int]]([]byte(`{"left":{},"right":1}`))
+	assert.True(t, invalid.IsError())
+}
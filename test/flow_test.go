@@ -0,0 +1,204 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/flowcheck"
+	"github.com/mobilemindtech/go-io/io"
+	iotypes "github.com/mobilemindtech/go-io/types"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+func mismatchIO() *iotypes.IO[string] {
+	return io.IO[string]().As("mismatch").
+		Pure(io.PureVal(1)).
+		Map(io.Map(func(s string) string { return s })).
+		Filter(io.Filter(func(f float64) bool { return f > 0 }))
+}
+
+func TestValidateFlow(t *testing.T) {
+	errs := mismatchIO().ValidateFlow()
+
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "mismatch", errs[0].IO)
+	assert.Equal(t, "IOMap", errs[0].Effect)
+	assert.Equal(t, "IOPure", errs[0].Previous)
+	assert.Equal(t, "string", errs[0].Expected.String())
+	assert.Equal(t, "int", errs[0].Actual.String())
+	assert.True(t, strings.HasSuffix(errs[0].Filename, "flow_test.go"))
+	assert.Equal(t, errs[0].Line+1, errs[1].Line)
+	assert.Equal(t, "IOFilter", errs[1].Effect)
+	assert.Equal(t, "IOMap", errs[1].Previous)
+
+	assert.Empty(t, io.IO[int](io.PureVal(1), io.Map(func(i int) int { return i })).ValidateFlow())
+}
+
+func TestValidateFlowEffectsCaller(t *testing.T) {
+	errs := io.IO[string](io.PureVal(1), io.Map(func(s string) string { return s })).ValidateFlow()
+
+	assert.Len(t, errs, 1)
+	assert.True(t, strings.HasSuffix(errs[0].Filename, "flow_test.go"))
+	assert.Contains(t, errs[0].Error(), "flow_test.go")
+}
+
+func TestCheckTypesFlowPanics(t *testing.T) {
+	assert.PanicsWithError(t, mismatchIO().ValidateFlow()[0].Error(), func() {
+		mismatchIO().CheckTypesFlow()
+	})
+}
+
+func TestIOAppValidate(t *testing.T) {
+	app := io.IOApp[string](mismatchIO())
+
+	res := app.Validate()
+	assert.True(t, res.IsError())
+
+	var flowErrs iotypes.FlowErrors
+	assert.True(t, errors.As(res.Failure(), &flowErrs))
+	assert.Len(t, flowErrs, 2)
+
+	run := app.UnsafeRun()
+	assert.True(t, run.IsError())
+	assert.Equal(t, res.Failure().Error(), run.Failure().Error())
+
+	valid := io.IOApp[int](io.IO[int](io.PureVal(1)))
+	assert.True(t, valid.Validate().IsOk())
+}
+
+func TestFlowCheckAnalyzer(t *testing.T) {
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.LoadAllSyntax}, "./testdata/flow")
+	assert.NoError(t, err)
+	assert.Zero(t, packages.PrintErrors(pkgs))
+
+	graph, err := checker.Analyze([]*analysis.Analyzer{flowcheck.Analyzer}, pkgs, nil)
+	assert.NoError(t, err)
+
+	var got []string
+	for _, act := range graph.Roots {
+		for _, diag := range act.Diagnostics {
+			got = append(got, fmt.Sprintf("%v: %v", act.Package.Fset.Position(diag.Pos).Line, diag.Message))
+		}
+	}
+
+	// want comments have the effect, its type, the previous effect and its type
+	source, _ := os.ReadFile("testdata/flow/flow.go")
+	var want []string
+	for i, line := range strings.Split(string(source), "\n") {
+		if _, comment, ok := strings.Cut(line, "// want "); ok {
+			f := strings.Fields(comment)
+			want = append(want, fmt.Sprintf("%v: IO %v expect type is %v, but last IO %v result type is %v",
+				i+1, f[0], f[1], f[2], f[3]))
+		}
+	}
+
+	assert.Equal(t, want, got)
+}
+
+// TestFlowCheckEffectsTable flowcheck table against TypeIn and TypeOut of the ios effects
+func TestFlowCheckEffectsTable(t *testing.T) {
+	fset := token.NewFileSet()
+	files, _ := filepath.Glob("../io/ios/*.go")
+
+	checked := 0
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		assert.NoError(t, err)
+
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || (fn.Name.Name != "TypeIn" && fn.Name.Name != "TypeOut") {
+				continue
+			}
+			name, params := effectReceiver(fn.Recv.List[0].Type)
+			if len(params) == 0 {
+				continue
+			}
+
+			in, out, ok := flowcheck.EffectFlow(name)
+			if !assert.True(t, ok, "effect %v is not in flowcheck table", name) {
+				continue
+			}
+			spec := in
+			if fn.Name.Name == "TypeOut" {
+				spec = out
+			}
+
+			typeFor := typeForArg(fn.Body)
+			if spec == "?" {
+				assert.Empty(t, typeFor, "%v.%v", name, fn.Name.Name)
+				continue
+			}
+			assert.Equal(t, flowSpecType(spec, params), typeFor, "%v.%v", name, fn.Name.Name)
+			checked++
+		}
+	}
+	assert.Greater(t, checked, 100)
+}
+
+// effectReceiver type name and type params of a method receiver
+func effectReceiver(expr ast.Expr) (string, []string) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	var params []string
+	switch recv := expr.(type) {
+	case *ast.IndexExpr:
+		params = append(params, types.ExprString(recv.Index))
+		expr = recv.X
+	case *ast.IndexListExpr:
+		for _, index := range recv.Indices {
+			params = append(params, types.ExprString(index))
+		}
+		expr = recv.X
+	}
+	return types.ExprString(expr), params
+}
+
+// typeForArg type argument of a method body that is only return reflect.TypeFor[X]()
+func typeForArg(body *ast.BlockStmt) string {
+	if len(body.List) != 1 {
+		return ""
+	}
+	ret, ok := body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return ""
+	}
+	call, ok := ret.Results[0].(*ast.CallExpr)
+	if !ok {
+		return ""
+	}
+	index, ok := call.Fun.(*ast.IndexExpr)
+	if !ok || types.ExprString(index.X) != "reflect.TypeFor" {
+		return ""
+	}
+	return types.ExprString(index.Index)
+}
+
+// flowSpecType type expression of a flowcheck table spec
+func flowSpecType(spec string, params []string) string {
+	if spec == "unit" {
+		return "*unit.Unit"
+	}
+	elem, slice := strings.CutPrefix(spec, "[]")
+	i := len(params) - 1
+	if elem != "last" {
+		i, _ = strconv.Atoi(elem)
+	}
+	if slice {
+		return "[]" + params[i]
+	}
+	return params[i]
+}
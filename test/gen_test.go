@@ -0,0 +1,67 @@
+package test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/internal/gen"
+	"github.com/stretchr/testify/assert"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files of testdata/gen")
+
+// TestGenGolden first arity of each family against testdata/gen/<family>.golden
+func TestGenGolden(t *testing.T) {
+	for _, family := range gen.Families {
+		t.Run(family.Name, func(t *testing.T) {
+			first := *family
+			first.To = first.From
+			files, err := gen.Generate(&first)
+			assert.NoError(t, err)
+			assert.Len(t, files, 1)
+
+			var src []byte
+			for _, it := range files {
+				src = it
+			}
+
+			golden := filepath.Join("testdata", "gen", family.Name+".golden")
+			if *updateGolden {
+				assert.NoError(t, os.MkdirAll(filepath.Dir(golden), 0o755))
+				assert.NoError(t, os.WriteFile(golden, src, 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), string(src))
+		})
+	}
+}
+
+// TestGenUpToDate generated files in the tree match the templates
+func TestGenUpToDate(t *testing.T) {
+	for _, family := range gen.Families {
+		files, err := gen.Generate(family)
+		assert.NoError(t, err)
+		for path, src := range files {
+			actual, err := os.ReadFile(filepath.Join("..", path))
+			assert.NoError(t, err)
+			assert.Equal(t, string(src), string(actual), "%v is out of date, run go generate", path)
+		}
+	}
+}
+
+func TestGenLookup(t *testing.T) {
+	family, err := gen.Lookup("ios.flatmap")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, family.To)
+
+	_, err = gen.Lookup("none")
+	assert.Error(t, err)
+
+	files, _ := gen.Generate(family)
+	assert.Len(t, files, 9)
+	assert.True(t, strings.HasPrefix(string(files["io/ios/io_flatmap10.go"]), "// Code generated"))
+}
@@ -0,0 +1,199 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mobilemindtech/go-io/http"
+	"github.com/mobilemindtech/go-io/http/httptest"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCachedClient(mock *httptest.MockServer, store http.CacheStore) *http.HttpClient[any, *User, *ApiError] {
+	return http.NewClient[any, *User, *ApiError]().
+		AsJSON().
+		WithCache(store).
+		WithRequester(mock.Requester())
+}
+
+func TestHttpCacheMaxAge(t *testing.T) {
+	mock := httptest.NewMockServer(t)
+	mock.Expect(http.GET, "/countries").
+		RespondHeader("Cache-Control", "public, max-age=60").
+		RespondJSON(200, &User{Name: "Brazil"})
+
+	client := newCachedClient(mock, http.NewLRUCacheStore(10))
+
+	first := client.Get("http://localhost/countries").Get()
+	second := client.Get("http://localhost/countries").Get()
+
+	assert.False(t, first.FromCache)
+	assert.True(t, second.FromCache)
+	assert.Equal(t, first.EntityBody.Get(), second.EntityBody.Get())
+	assert.NotSame(t, first.EntityBody.Get(), second.EntityBody.Get())
+}
+
+func TestHttpCacheETagRevalidation(t *testing.T) {
+	mock := httptest.NewMockServer(t)
+	mock.Expect(http.GET, "/countries").
+		RespondHeader("Cache-Control", "no-cache").
+		RespondHeader("ETag", `"v1"`).
+		RespondJSON(200, &User{Name: "Brazil"})
+	mock.Expect(http.GET, "/countries").
+		WithHeader("If-None-Match", `"v1"`).
+		Respond(304, "")
+
+	client := newCachedClient(mock, http.NewLRUCacheStore(10))
+
+	first := client.Get("http://localhost/countries").Get()
+	second := client.Get("http://localhost/countries").Get()
+
+	assert.False(t, first.FromCache)
+	assert.True(t, second.FromCache)
+	assert.Equal(t, 200, second.StatusCode)
+	assert.Equal(t, first.EntityBody.Get(), second.EntityBody.Get())
+}
+
+func TestHttpCacheFileStoreLastModified(t *testing.T) {
+	dir := t.TempDir()
+	lastModified := "Wed, 21 Oct 2015 07:28:00 GMT"
+
+	mock := httptest.NewMockServer(t)
+	mock.Expect(http.GET, "/countries").
+		RespondHeader("Last-Modified", lastModified).
+		RespondJSON(200, &User{Name: "Brazil"})
+	mock.Expect(http.GET, "/countries").
+		WithHeader("If-Modified-Since", lastModified).
+		RespondHeader("Cache-Control", "max-age=60").
+		Respond(304, "")
+
+	first := newCachedClient(mock, http.NewFileCacheStore(dir)).Get("http://localhost/countries").Get()
+	assert.False(t, first.FromCache)
+
+	store := http.NewFileCacheStore(dir)
+	second := newCachedClient(mock, store).Get("http://localhost/countries").Get()
+	assert.True(t, second.FromCache)
+	assert.Equal(t, "Brazil", second.EntityBody.Get().Name)
+
+	entry := store.Get("GET http://localhost/countries").Get()
+	assert.True(t, entry.IsFresh(entry.Expires.Add(-1)))
+}
+
+func TestHttpCacheNoStore(t *testing.T) {
+	mock := httptest.NewMockServer(t)
+	mock.Expect(http.GET, "/countries").
+		Times(2).
+		RespondHeader("Cache-Control", "no-store").
+		RespondHeader("ETag", `"v1"`).
+		RespondJSON(200, &User{Name: "Brazil"})
+
+	store := http.NewLRUCacheStore(10)
+	client := newCachedClient(mock, store)
+
+	assert.False(t, client.Get("http://localhost/countries").Get().FromCache)
+	assert.False(t, client.Get("http://localhost/countries").Get().FromCache)
+	assert.Equal(t, 0, store.Len())
+}
+
+func TestHttpCacheLRUStore(t *testing.T) {
+	store := http.NewLRUCacheStore(2)
+	store.Set("a", &http.CacheEntry{ETag: "a"})
+	store.Set("b", &http.CacheEntry{ETag: "b"})
+	store.Get("a")
+	store.Set("c", &http.CacheEntry{ETag: "c"})
+
+	assert.True(t, store.Get("a").NonEmpty())
+	assert.True(t, store.Get("b").IsEmpty())
+	assert.True(t, store.Get("c").NonEmpty())
+
+	store.Delete("a")
+	assert.Equal(t, 1, store.Len())
+}
+
+func TestHttpCacheVary(t *testing.T) {
+	mock := httptest.NewMockServer(t)
+	mock.Expect(http.GET, "/countries").
+		Times(2).
+		RespondHeader("Cache-Control", "max-age=60").
+		RespondHeader("Vary", "Accept-Language").
+		RespondJSON(200, &User{Name: "Brazil"})
+
+	store := http.NewLRUCacheStore(10)
+
+	pt := newCachedClient(mock, store).Header("Accept-Language", "pt")
+	en := newCachedClient(mock, store).Header("Accept-Language", "en")
+
+	assert.False(t, pt.Get("http://localhost/countries").Get().FromCache)
+	assert.False(t, en.Get("http://localhost/countries").Get().FromCache)
+	assert.True(t, en.Get("http://localhost/countries").Get().FromCache)
+	mock.Verify()
+}
+
+func TestHttpCacheAuthorization(t *testing.T) {
+	mock := httptest.NewMockServer(t)
+	mock.Expect(http.GET, "/me").
+		Times(2).
+		RespondHeader("Cache-Control", "max-age=60").
+		RespondJSON(200, &User{Name: "Ricardo"})
+	mock.Expect(http.GET, "/countries").
+		RespondHeader("Cache-Control", "public, max-age=60").
+		RespondJSON(200, &User{Name: "Brazil"})
+
+	store := http.NewLRUCacheStore(10)
+	client := newCachedClient(mock, store).Header("Authorization", "Bearer token")
+
+	assert.False(t, client.Get("http://localhost/me").Get().FromCache)
+	assert.False(t, client.Get("http://localhost/me").Get().FromCache)
+	assert.False(t, client.Get("http://localhost/countries").Get().FromCache)
+	assert.True(t, client.Get("http://localhost/countries").Get().FromCache)
+	mock.Verify()
+}
+
+func TestHttpCacheRevalidationKeepStoredEntry(t *testing.T) {
+	mock := httptest.NewMockServer(t)
+	mock.Expect(http.GET, "/countries").
+		RespondHeader("Cache-Control", "no-cache").
+		RespondHeader("ETag", `"v1"`).
+		RespondJSON(200, &User{Name: "Brazil"})
+	mock.Expect(http.GET, "/countries").
+		WithHeader("If-None-Match", `"v1"`).
+		RespondHeader("Cache-Control", "max-age=60").
+		Respond(304, "")
+
+	store := http.NewLRUCacheStore(10)
+	client := newCachedClient(mock, store)
+
+	client.Get("http://localhost/countries")
+	stored := store.Get("GET http://localhost/countries").Get()
+	expires := stored.Expires
+
+	assert.True(t, client.Get("http://localhost/countries").Get().FromCache)
+	assert.Equal(t, expires, stored.Expires)
+	assert.True(t, stored.NoCache)
+
+	refreshed := store.Get("GET http://localhost/countries").Get()
+	assert.NotSame(t, stored, refreshed)
+	assert.False(t, refreshed.NoCache)
+}
+
+func TestHttpCacheRevalidationKeepFreshness(t *testing.T) {
+	mock := httptest.NewMockServer(t)
+	mock.Expect(http.GET, "/countries").
+		RespondHeader("Cache-Control", "max-age=60, no-cache").
+		RespondHeader("ETag", `"v1"`).
+		RespondJSON(200, &User{Name: "Brazil"})
+	mock.Expect(http.GET, "/countries").
+		WithHeader("If-None-Match", `"v1"`).
+		Respond(304, "")
+
+	store := http.NewLRUCacheStore(10)
+	client := newCachedClient(mock, store)
+
+	client.Get("http://localhost/countries")
+	expires := store.Get("GET http://localhost/countries").Get().Expires
+
+	assert.True(t, client.Get("http://localhost/countries").Get().FromCache)
+
+	refreshed := store.Get("GET http://localhost/countries").Get()
+	assert.Equal(t, expires, refreshed.Expires)
+	assert.True(t, refreshed.NoCache)
+}
@@ -0,0 +1,130 @@
+package test
+
+import (
+	"errors"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/http"
+	"github.com/mobilemindtech/go-io/json"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/stretchr/testify/assert"
+)
+
+type ApiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func statusRequester(status int, body string) http.DoRequest {
+	return func(req *nethttp.Request) *result.Result[*http.Responser] {
+		return result.OfValue(&http.Responser{
+			StatusCode: status,
+			Header:     nethttp.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Raw:        option.None[*nethttp.Response](),
+		})
+	}
+}
+
+func TestHttpErrorDefaultIsOk(t *testing.T) {
+	res := http.NewClient[any, *User, *ApiError]().
+		AsJSON().
+		WithRequester(statusRequester(404, `{"code":"not_found"}`)).
+		Get("http://localhost/users/1")
+
+	assert.True(t, res.IsOk())
+	assert.Equal(t, "not_found", res.Get().EntityError.Get().Code)
+}
+
+func TestHttpErrorFailOnError(t *testing.T) {
+	res := http.NewClient[any, *User, *ApiError]().
+		AsJSON().
+		FailOnError().
+		WithRequester(statusRequester(409, `{"code":"duplicated","message":"user exists"}`)).
+		Post("http://localhost/users")
+
+	assert.True(t, res.IsError())
+	err := res.Failure()
+	assert.True(t, errors.Is(err, http.Conflict))
+	assert.False(t, errors.Is(err, http.NotFound))
+	assert.True(t, http.IsStatus(err, 409))
+
+	var httpErr *http.HttpError[*ApiError]
+	assert.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, 409, httpErr.StatusCode)
+	assert.Equal(t, "application/json", httpErr.Header.Get("Content-Type"))
+	assert.Contains(t, httpErr.Body(), "duplicated")
+	assert.Equal(t, "user exists", http.ErrorEntity[*ApiError](err).Get().Message)
+}
+
+func TestHttpErrorFailOnErrorAccepts2xx(t *testing.T) {
+	res := http.NewClient[any, *User, *ApiError]().
+		AsJSON().
+		FailOnError().
+		WithRequester(statusRequester(201, `{"name":"Ricardo"}`)).
+		Post("http://localhost/users")
+
+	assert.True(t, res.IsOk())
+	assert.Equal(t, "Ricardo", res.Get().EntityBody.Get().Name)
+}
+
+func TestHttpErrorStatusDecoder(t *testing.T) {
+	notFoundDecoder := json.NewJsonDecoder[*ApiError]()
+
+	res := http.NewClient[any, *User, *ApiError]().
+		SetEncoder(json.NewJsonEncoder[any]()).
+		FailOnError().
+		WithStatusDecoder(404, notFoundDecoder).
+		WithRequester(statusRequester(404, `{"code":"not_found"}`)).
+		Get("http://localhost/users/1")
+
+	assert.True(t, errors.Is(res.Failure(), http.NotFound))
+	assert.Equal(t, "not_found", http.ErrorEntity[*ApiError](res.Failure()).Get().Code)
+
+	res = http.NewClient[any, *User, *ApiError]().
+		AsJSON().
+		FailOnError().
+		WithRequester(statusRequester(500, `<html>error</html>`)).
+		Get("http://localhost/users/1")
+
+	assert.True(t, errors.Is(res.Failure(), http.InternalServerError))
+	assert.True(t, http.ErrorEntity[*ApiError](res.Failure()).IsEmpty())
+}
+
+func TestHttpErrorRequestError(t *testing.T) {
+	cause := errors.New("connection refused")
+	res := http.NewClient[any, *User, *ApiError]().
+		AsJSON().
+		WithRequester(func(req *nethttp.Request) *result.Result[*http.Responser] {
+			return result.OfError[*http.Responser](cause)
+		}).
+		Get("http://localhost/users/1")
+
+	var reqErr *http.RequestError
+	assert.True(t, errors.As(res.Failure(), &reqErr))
+	assert.True(t, errors.Is(res.Failure(), cause))
+}
+
+func TestRecoverStatus(t *testing.T) {
+	client := http.NewClient[any, *User, *ApiError]().
+		AsJSON().
+		FailOnError().
+		WithRequester(statusRequester(404, `{"code":"not_found"}`))
+
+	fallback := func(err error) *http.Response[*User, *ApiError] {
+		return &http.Response[*User, *ApiError]{
+			StatusCode: 404, EntityBody: option.Some(&User{Name: "guest"})}
+	}
+
+	res := rio.UnsafeRun(rio.RecoverStatus(client.GetRIO("http://localhost/users/1"), 404, fallback))
+	assert.Equal(t, "guest", res.Get().Get().EntityBody.Get().Name)
+
+	res = rio.UnsafeRun(rio.RecoverStatus(client.GetRIO("http://localhost/users/1"), 409, fallback))
+	assert.True(t, res.IsError())
+	assert.True(t, errors.Is(res.Failure(), http.NotFound))
+}
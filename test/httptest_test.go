@@ -0,0 +1,132 @@
+package test
+
+import (
+	"fmt"
+	"io"
+	nethttp "net/http"
+	gohttptest "net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/http"
+	"github.com/mobilemindtech/go-io/http/httptest"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordT capture test failures
+type recordT struct {
+	testing.TB
+	errors []string
+}
+
+func (this *recordT) Errorf(format string, args ...any) {
+	this.errors = append(this.errors, fmt.Sprintf(format, args...))
+}
+
+func TestHttpRecorderRecordAndReplay(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "users.json")
+	calls := 0
+
+	t.Run("record", func(t *testing.T) {
+		recorder := httptest.NewRecorder(t, cassette).
+			Redact("Authorization").
+			WithRequester(func(req *nethttp.Request) *result.Result[*http.Responser] {
+				calls++
+				return result.OfValue(&http.Responser{
+					StatusCode: 200,
+					Header:     nethttp.Header{"Content-Type": []string{"application/json"}},
+					Body:       io.NopCloser(strings.NewReader(`{"name":"Ricardo"}`)),
+					Raw:        option.None[*nethttp.Response](),
+				})
+			})
+
+		assert.True(t, recorder.IsRecording())
+		res := http.NewClient[any, *User, any]().
+			AsJSON().
+			Header("Authorization", "secret").
+			WithRequester(recorder.Requester()).
+			Get("http://localhost/users/1?a=1&b=2")
+		assert.Equal(t, "Ricardo", res.Get().EntityBody.Get().Name)
+	})
+
+	data, err := os.ReadFile(cassette)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "REDACTED")
+	assert.NotContains(t, string(data), "secret")
+
+	t.Run("replay", func(t *testing.T) {
+		recorder := httptest.NewRecorder(t, cassette)
+		assert.False(t, recorder.IsRecording())
+
+		res := http.NewClient[any, *User, any]().
+			AsJSON().
+			WithRequester(recorder.Requester()).
+			Get("http://localhost/users/1?b=2&a=1")
+		assert.Equal(t, "Ricardo", res.Get().EntityBody.Get().Name)
+	})
+
+	assert.Equal(t, 1, calls)
+
+	rt := &recordT{TB: t}
+	recorder := httptest.NewRecorder(rt, cassette).Mode(httptest.ModeReplay)
+	res := http.NewClient[any, *User, any]().
+		AsJSON().
+		WithRequester(recorder.Requester()).
+		Get("http://localhost/users/2")
+	assert.True(t, res.IsError())
+	assert.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0], "unexpected request GET http://localhost/users/2")
+}
+
+func TestHttpMockServer(t *testing.T) {
+	mock := httptest.NewMockServer(t)
+	mock.Expect(http.POST, "/users").
+		WithBody(`{"name": "Ricardo"}`).
+		WithHeader("Content-Type", "application/json").
+		RespondJSON(201, &User{Name: "Ricardo", Email: "ricardo@mail.com"})
+	mock.Expect(http.GET, "http://localhost/users/1").
+		Times(2).
+		Respond(404, "{}")
+
+	client := http.NewClient[any, *User, *ApiError]().
+		AsJSON().
+		WithSuccessStatus(201).
+		WithRequester(mock.Requester())
+
+	res := client.Post("http://localhost/users", map[string]string{"name": "Ricardo"})
+	assert.Equal(t, "ricardo@mail.com", res.Get().EntityBody.Get().Email)
+
+	for range 2 {
+		assert.Equal(t, 404, client.Get("http://localhost/users/1").Get().StatusCode)
+	}
+}
+
+func TestHttpMockServerVerify(t *testing.T) {
+	rt := &recordT{TB: t}
+	mock := httptest.NewMockServer(rt)
+	mock.Expect(http.GET, "/users").Times(2)
+	mock.Expect(http.DELETE, "/users/1")
+
+	server := gohttptest.NewServer(mock)
+	defer server.Close()
+
+	res, err := nethttp.Get(server.URL + "/users")
+	assert.Nil(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+
+	res, err = nethttp.Get(server.URL + "/accounts")
+	assert.Nil(t, err)
+	assert.Equal(t, 501, res.StatusCode)
+
+	mock.Verify()
+	assert.Equal(t, []string{
+		"unexpected request GET /accounts",
+		"expected GET /users to be called 2 times, but was called 1 times",
+		"expected DELETE /users/1 to be called 1 times, but was called 0 times",
+	}, rt.errors)
+	rt.errors = nil
+}
@@ -59,7 +59,7 @@ func TestFlatMap(t *testing.T) {
 	r :=
 		types.NewIO[string]().
 			Pure(io.PureVal(1)).
-			FlatMap(io.FlatMap[int, string](func(i int) types.IORunnable {
+			FlatMap(io.FlatMap[int, string](func(i int) *types.IO[string] {
 				return types.NewIO[string]().Pure(io.PureVal(fmt.Sprintf("value is %v", i)))
 			})).
 			UnsafeRun()
@@ -72,7 +72,7 @@ func TestAttempt(t *testing.T) {
 	r1 :=
 		types.NewIO[string]().
 			Pure(io.PureVal(1)).
-			FlatMap(io.FlatMap[int, string](func(i int) types.IORunnable {
+			FlatMap(io.FlatMap[int, string](func(i int) *types.IO[string] {
 				return types.NewIO[string]().
 					Attempt(io.Attempt[string](func() *result.Result[string] {
 						return result.OfValue(fmt.Sprintf("success %v", i))
@@ -82,7 +82,7 @@ func TestAttempt(t *testing.T) {
 	r2 :=
 		types.NewIO[string]().
 			Pure(io.PureVal(1)).
-			FlatMap(io.FlatMap[int, string](func(i int) types.IORunnable {
+			FlatMap(io.FlatMap[int, string](func(i int) *types.IO[string] {
 				return types.NewIO[string]().
 					Attempt(io.AttemptOfError[string](func() (string, error) {
 						return fmt.Sprintf("success %v", 1), nil
@@ -93,7 +93,7 @@ func TestAttempt(t *testing.T) {
 	r3 :=
 		types.NewIO[string]().
 			Pure(io.PureVal(1)).
-			FlatMap(io.FlatMap[int, string](func(i int) types.IORunnable {
+			FlatMap(io.FlatMap[int, string](func(i int) *types.IO[string] {
 				return types.NewIO[string]().
 					Attempt(io.AttemptOfError[string](func() (string, error) {
 						return "", errors.New("ERROR!")
@@ -112,7 +112,7 @@ func TestRecover(t *testing.T) {
 	r :=
 		types.NewIO[string]().
 			Pure(io.PureVal(1)).
-			FlatMap(io.FlatMap[int, string](func(i int) types.IORunnable {
+			FlatMap(io.FlatMap[int, string](func(i int) *types.IO[string] {
 				return types.NewIO[string]().
 					Attempt(io.AttemptOfError[string](func() (string, error) {
 						return "", errors.New("ERROR!")
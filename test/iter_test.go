@@ -0,0 +1,72 @@
+package test
+
+import (
+	"errors"
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/collections"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionResultAll(t *testing.T) {
+	assert.Equal(t, []int{1}, slices.Collect(option.Some(1).All()))
+	assert.Empty(t, slices.Collect(option.None[int]().All()))
+
+	assert.Equal(t, []string{"ok"}, slices.Collect(result.OfValue("ok").All()))
+	assert.Empty(t, slices.Collect(result.OfError[string](errors.New("fail")).All()))
+
+	total := 0
+	for v := range option.Some(10).All() {
+		total += v
+	}
+	assert.Equal(t, 10, total)
+}
+
+func TestStackIterators(t *testing.T) {
+	stack := collections.NewStack[int]().Push(1).Push(2).Push(3)
+
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(stack.All()))
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(stack.Values()))
+
+	for v := range stack.All() {
+		assert.Equal(t, 3, v)
+		break
+	}
+}
+
+func TestRIOSeq(t *testing.T) {
+	calls := 0
+	io := rio.SeqFilter(
+		rio.SeqMap(rio.FromSeq(slices.Values([]int{1, 2, 3, 4})), func(i int) int {
+			calls++
+			return i * 10
+		}),
+		func(i int) bool { return i > 10 })
+
+	seq := rio.UnsafeRun(io).Get().Get()
+	assert.Equal(t, 0, calls)
+
+	for v := range seq {
+		assert.Equal(t, 20, v)
+		break
+	}
+	assert.Equal(t, 2, calls)
+
+	assert.Equal(t, []int{20, 30, 40}, rio.UnsafeRun(rio.SeqToSlice(io)).Get().Get())
+
+	var keys []string
+	unit := rio.ForeachSeq(rio.FromSeq(maps.Keys(map[string]int{"a": 1})), func(k string) {
+		keys = append(keys, k)
+	})
+	assert.True(t, rio.UnsafeRun(unit).IsOk())
+	assert.Equal(t, []string{"a"}, keys)
+
+	failed := rio.SeqMap(rio.Errorf[iter.Seq[int]]("fail"), func(i int) int { return i })
+	assert.True(t, rio.UnsafeRun(failed).IsError())
+}
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/http"
+	"github.com/mobilemindtech/go-io/json"
+	"github.com/mobilemindtech/go-io/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type Item struct {
+	Id   int    `json:"id"`
+	Name string `json:"name" validate:"required"`
+}
+
+func TestJsonStreamArray(t *testing.T) {
+	reader := strings.NewReader(` [{"id":1,"name":"a"}, {"id":2,"name":"b"}, {"id":3,"name":"c"}]`)
+
+	var ids []int
+	for res := range json.DecodeStream[*Item](reader) {
+		assert.False(t, res.IsError())
+		ids = append(ids, res.Get().Id)
+		if len(ids) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, ids)
+}
+
+func TestJsonStreamNDJson(t *testing.T) {
+	reader := strings.NewReader("{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}\n")
+	res := json.NewStream[Item](reader).Collect()
+	assert.False(t, res.IsError())
+	assert.Equal(t, []Item{{1, "a"}, {2, "b"}}, res.Get())
+
+	res = json.NewStream[Item](strings.NewReader("")).Collect()
+	assert.Empty(t, res.Get())
+}
+
+func TestJsonStreamErrors(t *testing.T) {
+	reader := strings.NewReader(`[{"id":1,"name":"a"},{"id":"x","name":"b"}]`)
+	res := json.NewStream[*Item](reader).Collect()
+	assert.True(t, res.IsError())
+
+	var decodeError *json.DecodeError
+	assert.True(t, errors.As(res.Failure(), &decodeError))
+	assert.Equal(t, "$[1].id", decodeError.Path)
+	assert.Equal(t, int64(30), decodeError.Offset)
+
+	reader = strings.NewReader(`[{"id":1,"name":"a","extra":true}]`)
+	res = json.NewStream[*Item](reader).Strict().Collect()
+	assert.True(t, res.IsError())
+	assert.Contains(t, res.Failure().Error(), "unknown field \"extra\"")
+
+	reader = strings.NewReader(`[{"id":1,"name":"a"},{"id":2}]`)
+	res = json.NewStream[*Item](reader).Validated().Collect()
+	assert.True(t, res.IsError())
+	assert.Equal(t, "$[1]", res.Failure().(*json.DecodeError).Path)
+	assert.True(t, validation.AsFailure(res.Failure()).NonEmpty())
+}
+
+func TestJsonDecodeStrictAndValidated(t *testing.T) {
+	res := json.DecodeStrict[*Item]([]byte(`{"id":1,"name":"a","extra":1}`))
+	assert.True(t, res.IsError())
+
+	res = json.Decode[*Item]([]byte(`{"id":1,"name":"a","extra":1}`))
+	assert.Equal(t, "a", res.Get().Name)
+
+	res = json.Decode[*Item]([]byte(`{"id":1,"name":`))
+	var decodeError *json.DecodeError
+	assert.True(t, errors.As(res.Failure(), &decodeError))
+	assert.Equal(t, "$", decodeError.Path)
+
+	assert.True(t, json.Decode[*Item]([]byte(`{"id":1,"name":"a"}]`)).IsError())
+	assert.True(t, json.Decode[*Item]([]byte(`{"id":1,"name":"a"} {}`)).IsError())
+	assert.Equal(t, "a", json.Decode[*Item]([]byte(" {\"id\":1,\"name\":\"a\"}\n ")).Get().Name)
+
+	res = json.DecodeValidated[*Item]([]byte(`{"id":1}`))
+	assert.True(t, res.IsError())
+	failure := validation.AsFailure(res.Failure()).Get()
+	assert.Equal(t, "name", failure.Fields[0].Path)
+
+	anyValue := json.Decode[any]([]byte(`{"id":1}`))
+	assert.Equal(t, map[string]any{"id": float64(1)}, anyValue.Get())
+}
+
+func TestJsonStreamHttpDecoder(t *testing.T) {
+	res := http.NewClient[any, []*Item, *ApiError]().
+		AsJSON().
+		SetDecoder(json.NewStreamDecoder[*Item]().Validated()).
+		WithRequester(statusRequester(200, "{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}")).
+		Get("http://localhost/items")
+
+	assert.True(t, res.IsOk())
+	assert.Len(t, res.Get().EntityBody.Get(), 2)
+}
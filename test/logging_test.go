@@ -0,0 +1,193 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/io"
+	"github.com/mobilemindtech/go-io/logging"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/pipeline"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/stretchr/testify/assert"
+)
+
+func newJsonLogger() (*slog.Logger, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})), buf
+}
+
+func logRecords(buf *bytes.Buffer) []map[string]any {
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		record := map[string]any{}
+		_ = json.Unmarshal([]byte(line), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestLoggingRIOLog(t *testing.T) {
+	logger, buf := newJsonLogger()
+
+	res := rio.Pure(10).WithLogger(logger).Log("loaded", "user", 1).UnsafeRun()
+	assert.Equal(t, 10, res.UnsafeGet())
+
+	records := logRecords(buf)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "loaded", records[0]["msg"])
+	assert.Equal(t, "INFO", records[0]["level"])
+	assert.Equal(t, float64(10), records[0]["value"])
+	assert.Equal(t, float64(1), records[0]["user"])
+	assert.Equal(t, "Log", records[0][logging.KeyIO])
+	assert.NotEmpty(t, records[0][logging.KeyRunId])
+}
+
+func TestLoggingRIOLogSpan(t *testing.T) {
+	logger, buf := newJsonLogger()
+
+	rio.Attempt(func() *result.Result[int] {
+		return result.OfError[int](errors.New("boom"))
+	}).WithLogger(logger).LogSpan("load").UnsafeRun()
+
+	records := logRecords(buf)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "load start", records[0]["msg"])
+	assert.Equal(t, "load end", records[1]["msg"])
+	assert.Equal(t, "ERROR", records[1]["level"])
+	assert.Equal(t, "boom", records[1][logging.KeyError])
+	assert.Contains(t, records[1], "duration")
+	assert.Equal(t, records[0][logging.KeyRunId], records[1][logging.KeyRunId])
+}
+
+func TestLoggingQuietByDefault(t *testing.T) {
+	logger, buf := newJsonLogger()
+	prev := logging.Logger()
+	logging.SetLogger(logger)
+	t.Cleanup(func() { logging.SetLogger(prev) })
+
+	res := rio.Attempt(func() *result.Result[int] { panic("boom") }).UnsafeRun()
+	assert.True(t, res.IsError())
+	assert.Empty(t, buf.String())
+
+	rio.Attempt(func() *result.Result[int] { panic("boom") }).Debug().UnsafeRun()
+	records := logRecords(buf)
+	assert.NotEmpty(t, records)
+	assert.Equal(t, "INFO", records[len(records)-1]["level"])
+	assert.Contains(t, records[len(records)-1], logging.KeyStack)
+}
+
+func TestLoggingIOApp(t *testing.T) {
+	logger, buf := newJsonLogger()
+
+	io.IOApp[int]().
+		As("calc").
+		WithLogger(logger).
+		Debug().
+		Effects(io.IO[int]().As("x").Pure(io.PureVal(2))).
+		UnsafeRun()
+
+	records := logRecords(buf)
+	assert.NotEmpty(t, records)
+	runId := records[0][logging.KeyRunId]
+	assert.NotEmpty(t, runId)
+
+	var effect map[string]any
+	for _, record := range records {
+		assert.Equal(t, runId, record[logging.KeyRunId])
+		assert.Equal(t, "calc", record[logging.KeyApp])
+		if record["msg"] == "effect run" {
+			effect = record
+		}
+	}
+	assert.Equal(t, "IOPure", effect[logging.KeyEffect])
+	assert.Equal(t, "x", effect[logging.KeyIO])
+	assert.Contains(t, effect[logging.KeyCaller], "logging_test.go:")
+}
+
+func TestLoggingPipelinePanicStack(t *testing.T) {
+	logger, buf := newJsonLogger()
+
+	res := pipeline.New[int]().
+		WithLogger(logger).
+		Next(func() int { panic("boom") }).
+		UnsafeRun()
+	assert.True(t, res.IsError())
+	records := logRecords(buf)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "boom", records[0][logging.KeyError])
+	assert.NotContains(t, records[0], logging.KeyStack)
+
+	buf.Reset()
+	pipeline.New[int]().
+		WithLogger(logger).
+		Debug().
+		Next(func() int { panic("boom") }).
+		UnsafeRun()
+	records = logRecords(buf)
+	assert.Contains(t, records[len(records)-1], logging.KeyStack)
+}
+
+func TestLoggingDebugWithDefaultLevel(t *testing.T) {
+	buf := &bytes.Buffer{}
+	prev := logging.Logger()
+	logging.SetHandler(slog.NewJSONHandler(buf, nil))
+	t.Cleanup(func() { logging.SetLogger(prev) })
+
+	rio.Debug(rio.Pure(1), "value").UnsafeRun()
+	option.Some(2).Debug()
+
+	records := logRecords(buf)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "value", records[0]["msg"])
+	assert.Equal(t, "option", records[1]["msg"])
+}
+
+func TestLoggingIOAppKeepIOLogger(t *testing.T) {
+	appLogger, appBuf := newJsonLogger()
+	ioLogger, ioBuf := newJsonLogger()
+
+	io.IOApp[int]().
+		WithLogger(appLogger).
+		Debug().
+		Effects(
+			io.IO[int]().As("x").WithLogger(ioLogger).Pure(io.PureVal(2)),
+			io.IO[int]().As("y").Pure(io.PureVal(3))).
+		UnsafeRun()
+
+	ioVars := map[any]bool{}
+	for _, record := range logRecords(ioBuf) {
+		ioVars[record[logging.KeyIO]] = true
+	}
+	appVars := map[any]bool{}
+	for _, record := range logRecords(appBuf) {
+		if record["msg"] == "effect run" {
+			appVars[record[logging.KeyIO]] = true
+		}
+	}
+	assert.Equal(t, map[any]bool{"x": true}, ioVars)
+	assert.Equal(t, map[any]bool{"y": true}, appVars)
+}
+
+func TestLoggingEffectPanicLogger(t *testing.T) {
+	logger, buf := newJsonLogger()
+
+	eff := io.Attempt(func() *result.Result[int] { panic("boom") })
+	eff.SetDebug(true)
+	eff.SetLogger(logger)
+	eff.UnsafeRun()
+
+	records := logRecords(buf)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "panic", records[0]["msg"])
+	assert.Equal(t, "IOAttempt", records[0][logging.KeyEffect])
+	assert.Contains(t, records[0], logging.KeyStack)
+}
@@ -0,0 +1,168 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mobilemindtech/go-io/io"
+	"github.com/mobilemindtech/go-io/metrics"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/pipeline"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/mobilemindtech/go-io/scheduler"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/mobilemindtech/go-io/types/unit"
+	"github.com/stretchr/testify/assert"
+)
+
+func withMetrics(t *testing.T, m metrics.Metrics) {
+	metrics.SetMetrics(m)
+	t.Cleanup(func() { metrics.SetMetrics(nil) })
+}
+
+func TestMetricsRIO(t *testing.T) {
+	m := metrics.NewInMemory()
+	withMetrics(t, m)
+
+	rio.Pure(1).As("LoadUser").UnsafeRun()
+	rio.Attempt(func() *result.Result[int] {
+		return result.OfError[int](errors.New("boom"))
+	}).As("LoadUser").UnsafeRun()
+	rio.AttemptThenOfOption(rio.Pure(1), func(int) *result.Result[*option.Option[int]] {
+		return result.OfValue(option.None[int]())
+	}).As("FindUser").UnsafeRun()
+
+	labels := metrics.Labels{"runtime": "rio", "name": "LoadUser"}
+	assert.Equal(t, float64(2), m.Counter(metrics.Runs, labels))
+	assert.Len(t, m.Observations(metrics.RunDuration, labels), 2)
+	assert.Equal(t, float64(1), m.Counter(metrics.Failures,
+		metrics.Labels{"runtime": "rio", "name": "LoadUser", "error_type": "*errors.errorString"}))
+	assert.Equal(t, float64(1), m.Counter(metrics.Empty, metrics.Labels{"runtime": "rio", "name": "FindUser"}))
+}
+
+func TestMetricsRIOSteps(t *testing.T) {
+	m := metrics.NewInMemory()
+	withMetrics(t, m)
+
+	rio.FlatMap(rio.Pure(1).As("LoadUser"), func(i int) *rio.IO[int] {
+		return rio.Map(rio.Pure(i), func(i int) int { return i + 1 })
+	}).As("Job").UnsafeRun()
+
+	assert.Equal(t, float64(1), m.Counter(metrics.Runs, metrics.Labels{"runtime": "rio", "name": "Job"}))
+	assert.Equal(t, float64(1), m.Counter(metrics.Runs, metrics.Labels{"runtime": "rio", "name": "LoadUser"}))
+	assert.Zero(t, m.Counter(metrics.Runs, metrics.Labels{"runtime": "rio", "name": "Map"}))
+	assert.Zero(t, m.Counter(metrics.Runs, metrics.Labels{"runtime": "rio", "name": "Pure"}))
+	assert.Len(t, m.Observations(metrics.StepDuration,
+		metrics.Labels{"runtime": "rio", "name": "Job", "step": "Map"}), 1)
+	assert.Len(t, m.Observations(metrics.StepDuration,
+		metrics.Labels{"runtime": "rio", "name": "Job", "step": "Pure"}), 1)
+
+	m.Reset()
+	rio.Map(rio.Pure(1), func(i int) int { return i }).UnsafeRun()
+	assert.Equal(t, float64(1), m.Counter(metrics.Runs, metrics.Labels{"runtime": "rio", "name": "Map"}))
+	assert.Zero(t, m.Counter(metrics.Runs, metrics.Labels{"runtime": "rio", "name": "Pure"}))
+}
+
+func TestMetricsIOApp(t *testing.T) {
+	m := metrics.NewInMemory()
+	withMetrics(t, m)
+
+	io.IOApp[int]().
+		As("sum").
+		Effects(io.IO[int]().As("x").Pure(io.PureVal(2))).
+		UnsafeRun()
+
+	assert.Equal(t, float64(1), m.Counter(metrics.Runs, metrics.Labels{"runtime": "io_app", "name": "sum"}))
+	assert.Len(t, m.Observations(metrics.StepDuration,
+		metrics.Labels{"runtime": "io_app", "name": "sum", "step": "x"}), 1)
+}
+
+func TestMetricsPipeline(t *testing.T) {
+	m := metrics.NewInMemory()
+	withMetrics(t, m)
+
+	pipeline.New[int]().
+		As("calc").
+		Next(func() int { return 5 }).
+		Next(func(x int) int { panic("fail") }).
+		UnsafeRun()
+
+	labels := metrics.Labels{"runtime": "pipeline", "name": "calc"}
+	assert.Equal(t, float64(1), m.Counter(metrics.Panics, labels))
+	assert.Equal(t, float64(1), m.Counter(metrics.Runs, labels))
+	assert.Len(t, m.Observations(metrics.StepDuration,
+		metrics.Labels{"runtime": "pipeline", "name": "calc", "step": "0"}), 1)
+}
+
+func TestMetricsPipelineForEachStep(t *testing.T) {
+	m := metrics.NewInMemory()
+	withMetrics(t, m)
+
+	names := state.NewKey[[]string]("names")
+	pipeline.New[[]int]().
+		As("names").
+		Step(pipeline.Step0(names, func() ([]string, error) { return []string{"ana", "bob", "carla"}, nil })).
+		ForEach("names", "name", pipeline.New[int]().Next(func(name string) int { return len(name) })).
+		UnsafeRun()
+
+	// item indexes are not in step label
+	step := func(label string) metrics.Labels {
+		return metrics.Labels{"runtime": "pipeline", "name": "names", "step": label}
+	}
+	assert.Len(t, m.Observations(metrics.StepDuration, step("1.0")), 3)
+	assert.Len(t, m.Observations(metrics.StepDuration, step("1")), 1)
+	assert.Empty(t, m.Observations(metrics.StepDuration, step("1.2.0")))
+}
+
+func TestMetricsSchedulerEmptyRun(t *testing.T) {
+	m := metrics.NewInMemory()
+	withMetrics(t, m)
+
+	clock := scheduler.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	started := make(chan struct{})
+	job := rio.FlatMap(rio.PureF(func() *unit.Unit {
+		close(started)
+		return unit.OfUnit()
+	}), func(*unit.Unit) *rio.IO[*unit.Unit] { return rio.NewEmptyIO[*unit.Unit]() })
+
+	s := scheduler.New().WithClock(clock).
+		Add(scheduler.NewJob("poll", scheduler.Every(time.Minute), job)).
+		Start()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	<-started
+	assert.Nil(t, s.Stop(context.Background()))
+
+	labels := metrics.Labels{"runtime": "scheduler", "name": "poll"}
+	assert.Equal(t, float64(1), m.Counter(metrics.Empty, labels))
+}
+
+func TestMetricsPrometheus(t *testing.T) {
+	prom := metrics.NewPrometheus(0.5, 1)
+	prom.Inc(metrics.Runs, metrics.Labels{"name": "a\"b"})
+	prom.Observe(metrics.RunDuration, 0.2, metrics.Labels{"name": "a"})
+	prom.Observe(metrics.RunDuration, 0.7, metrics.Labels{"name": "a"})
+
+	rec := httptest.NewRecorder()
+	prom.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	expected := strings.Join([]string{
+		`# TYPE io_runs_total counter`,
+		`io_runs_total{name="a\"b"} 1`,
+		`# TYPE io_run_duration_seconds histogram`,
+		`io_run_duration_seconds_bucket{le="0.5",name="a"} 1`,
+		`io_run_duration_seconds_bucket{le="1",name="a"} 2`,
+		`io_run_duration_seconds_bucket{le="+Inf",name="a"} 2`,
+		`io_run_duration_seconds_sum{name="a"} 0.8999999999999999`,
+		`io_run_duration_seconds_count{name="a"} 2`,
+		``,
+	}, "\n")
+	assert.Equal(t, expected, rec.Body.String())
+	assert.True(t, strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain"))
+}
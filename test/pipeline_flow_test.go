@@ -0,0 +1,165 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/pipeline"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelineWhen(t *testing.T) {
+	run := func(age int) *result.Result[*option.Option[string]] {
+		return pipeline.New[string]().
+			Next(func() int { return age }).
+			Next(func(i int) string { return "user" }).
+			When(func(i int) bool { return i >= 18 },
+				pipeline.New[string]().Next(func(s string) string { return s + ":adult" })).
+			UnsafeRun()
+	}
+
+	assert.Equal(t, "user:adult", run(20).Get().Get())
+	assert.Equal(t, "user", run(10).Get().Get())
+}
+
+func TestPipelineWhenShortCircuit(t *testing.T) {
+	res := pipeline.New[int]().
+		Next(func() int { return 1 }).
+		When(func(i int) bool { return true },
+			pipeline.New[int]().Next(func(i int) *option.Option[int] { return option.None[int]() })).
+		Next(func(i int) int { return 99 }).
+		UnsafeRun()
+
+	assert.True(t, res.Get().IsEmpty())
+
+	res = pipeline.New[int]().
+		Next(func() int { return 1 }).
+		When(func(i int) bool { return true },
+			pipeline.New[int]().Next(func(i int) (int, error) { return 0, errors.New("branch error") })).
+		UnsafeRun()
+
+	assert.Equal(t, "branch error", res.Failure().Error())
+}
+
+func TestPipelineSwitch(t *testing.T) {
+	run := func(kind string) *result.Result[*option.Option[string]] {
+		return pipeline.New[string]().
+			Next(func() string { return kind }).
+			Switch(func(kind string) string { return kind }, pipeline.Cases{
+				"pf":             pipeline.New[string]().Next(func() string { return "person" }),
+				"pj":             pipeline.New[string]().Next(func() string { return "company" }),
+				pipeline.Default: pipeline.New[string]().Next(func() string { return "unknown" }),
+			}).
+			UnsafeRun()
+	}
+
+	assert.Equal(t, "person", run("pf").Get().Get())
+	assert.Equal(t, "company", run("pj").Get().Get())
+	assert.Equal(t, "unknown", run("other").Get().Get())
+}
+
+func TestPipelineParallel(t *testing.T) {
+	res := pipeline.New[string]().
+		Next(func() int { return 2 }).
+		Parallel(
+			pipeline.New[string]().Next(func(i int) string { return strings.Repeat("a", i) }),
+			pipeline.New[float64]().Next(func(i int) float64 { return float64(i) / 4 }),
+		).
+		Next(func(s string, f float64, i int) string { return s + ":" + strings.Repeat("b", int(f*float64(i))) }).
+		UnsafeRun()
+
+	assert.Equal(t, "aa:b", res.Get().Get())
+
+	res = pipeline.New[string]().
+		Parallel(
+			pipeline.New[string]().Next(func() string { return "ok" }),
+			pipeline.New[string]().Next(func() (string, error) { return "", errors.New("parallel error") }),
+		).
+		UnsafeRun()
+
+	assert.Equal(t, "parallel error", res.Failure().Error())
+}
+
+func TestPipelineParallelUpdatedVar(t *testing.T) {
+	count := state.NewKey[int]("count")
+
+	res := pipeline.New[int]().
+		Step(pipeline.Step0(count, func() (int, error) { return 1, nil })).
+		Parallel(
+			pipeline.New[int]().Step(pipeline.Step1(count, count, func(i int) (int, error) { return i + 10, nil })),
+			pipeline.New[string]().Next(func() string { return "other" }),
+		).
+		Step(pipeline.Step1(count, count, func(i int) (int, error) { return i, nil })).
+		UnsafeRun()
+
+	assert.Equal(t, 11, res.Get().Get())
+}
+
+func TestPipelineForEach(t *testing.T) {
+	names := state.NewKey[[]string]("names")
+
+	res := pipeline.New[[]int]().
+		Step(pipeline.Step0(names, func() ([]string, error) { return []string{"ana", "bob", "carla"}, nil })).
+		ForEach("names", "name", pipeline.New[int]().Next(func(name string) int { return len(name) })).
+		UnsafeRun()
+
+	assert.Equal(t, []int{3, 3, 5}, res.Get().Get())
+
+	res = pipeline.New[[]int]().
+		ForEach("names", "name", pipeline.New[int]().Next(func(name string) int { return len(name) })).
+		UnsafeRun()
+
+	assert.Equal(t, "var names not found on state", res.Failure().Error())
+}
+
+func TestPipelineForEachItemVar(t *testing.T) {
+	names := state.NewKey[[]string]("names")
+	prefix := state.NewKey[string]("prefix")
+	name := state.NewKey[string]("name")
+
+	res := pipeline.New[[]string]().
+		Next(func() string { return "outer" }).
+		Step(pipeline.Step0(names, func() ([]string, error) { return []string{"ana", "bob"}, nil })).
+		ForEach("names", "name", pipeline.New[string]().Next(func(name string) string { return strings.ToUpper(name) })).
+		UnsafeRun()
+
+	assert.Equal(t, []string{"ANA", "BOB"}, res.Get().Get())
+
+	res = pipeline.New[[]string]().
+		Step(pipeline.Step0(prefix, func() (string, error) { return "user:", nil })).
+		Step(pipeline.Step0(names, func() ([]string, error) { return []string{"ana", "bob"}, nil })).
+		ForEach("names", "name", pipeline.New[string]().
+			Step(pipeline.Step2(prefix, name, state.TypeKey[string](),
+				func(prefix string, name string) (string, error) { return prefix + name, nil }))).
+		UnsafeRun()
+
+	assert.Equal(t, []string{"user:ana", "user:bob"}, res.Get().Get())
+}
+
+func TestPipelineFinally(t *testing.T) {
+	var calls []string
+
+	res := pipeline.New[int]().
+		Next(func() (int, error) { return 0, errors.New("fail") }).
+		Finally(func(res *result.Result[*option.Option[int]]) {
+			calls = append(calls, res.Failure().Error())
+		}).
+		UnsafeRun()
+
+	assert.True(t, res.IsError())
+	assert.Equal(t, []string{"fail"}, calls)
+
+	pipeline.New[int]().
+		Next(func() int { panic("boom") }).
+		Finally(func(res *result.Result[*option.Option[int]]) {
+			calls = append(calls, "panic")
+			assert.True(t, res.IsError())
+		}).
+		UnsafeRun()
+
+	assert.Equal(t, []string{"fail", "panic"}, calls)
+}
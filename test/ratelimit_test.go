@@ -0,0 +1,119 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mobilemindtech/go-io/http"
+	"github.com/mobilemindtech/go-io/http/httptest"
+	"github.com/mobilemindtech/go-io/ratelimit"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucketAllow(t *testing.T) {
+	limiter := ratelimit.NewTokenBucket(1, 2)
+
+	assert.True(t, limiter.Allow("a"))
+	assert.True(t, limiter.Allow("a"))
+	assert.False(t, limiter.Allow("a"))
+	// keys have independent buckets
+	assert.True(t, limiter.Allow("b"))
+
+	limiter.Pause("b", time.Minute)
+	assert.False(t, limiter.Allow("b"))
+}
+
+func TestTokenBucketWait(t *testing.T) {
+	limiter := ratelimit.NewTokenBucket(20, 1)
+
+	start := time.Now()
+	for range 3 {
+		assert.Nil(t, limiter.Wait(context.Background(), ""))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	limiter.Pause("", time.Minute)
+	assert.ErrorIs(t, limiter.Wait(ctx, ""), context.DeadlineExceeded)
+}
+
+func TestTokenBucketWaitAfterPause(t *testing.T) {
+	limiter := ratelimit.NewTokenBucket(20, 1)
+	assert.True(t, limiter.Allow(""))
+
+	// pause and token deficit add up
+	limiter.Pause("", 50*time.Millisecond)
+	start := time.Now()
+	assert.Nil(t, limiter.Wait(context.Background(), ""))
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestLeakyBucket(t *testing.T) {
+	limiter := ratelimit.NewLeakyBucket(20, 1)
+
+	assert.True(t, limiter.Allow(""))
+	assert.False(t, limiter.Allow(""))
+
+	start := time.Now()
+	assert.Nil(t, limiter.Wait(context.Background(), ""))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	assert.Nil(t, limiter.Wait(context.Background(), "other"))
+
+	noQueue := ratelimit.NewLeakyBucket(20, 0)
+	assert.Nil(t, noQueue.Wait(context.Background(), ""))
+	assert.ErrorIs(t, noQueue.Wait(context.Background(), ""), ratelimit.ErrLimitExceeded)
+}
+
+func TestRIORateLimited(t *testing.T) {
+	limiter := ratelimit.NewTokenBucket(20, 1)
+	count := 0
+	io := rio.PureF(func() int {
+		count++
+		return count
+	})
+
+	start := time.Now()
+	for range 3 {
+		assert.True(t, rio.UnsafeRun(rio.RateLimited(limiter, io, "partner")).IsOk())
+	}
+	assert.Equal(t, 3, count)
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRIORateLimitedWithContext(t *testing.T) {
+	limiter := ratelimit.NewTokenBucket(20, 1)
+	limiter.Pause("partner", time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	res := rio.UnsafeRun(rio.WithContext(ctx, rio.RateLimited(limiter, rio.Pure(1), "partner")))
+	assert.ErrorIs(t, res.Failure(), context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestHttpClientRetryAfter(t *testing.T) {
+	mock := httptest.NewMockServer(t)
+	mock.Expect(http.GET, "http://partner.com/quota").
+		RespondHeader("Retry-After", "120").
+		Respond(429, "{}")
+
+	limiter := ratelimit.NewTokenBucket(100, 10)
+	client := http.NewClient[any, *User, *ApiError]().
+		AsJSON().
+		WithLimiter(limiter).
+		WithRequester(mock.Requester())
+
+	assert.Equal(t, 429, client.Get("http://partner.com/quota").Get().StatusCode)
+	assert.False(t, limiter.Allow("partner.com"))
+	assert.True(t, limiter.Allow("other.com"))
+
+	assert.Equal(t, 2*time.Minute, http.ParseRetryAfter("120").Get())
+	assert.True(t, http.ParseRetryAfter("invalid").IsEmpty())
+	assert.Equal(t, time.Duration(0), http.ParseRetryAfter("Wed, 21 Oct 2015 07:28:00 GMT").Get())
+}
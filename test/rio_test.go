@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/mobilemindtech/go-io/http"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"strconv"
 	"testing"
+	"time"
 )
 
 func TestNewIO(t *testing.T) {
@@ -98,3 +100,26 @@ func TestHttpRIO(t *testing.T) {
 	assert.Equal(t, 200, rio.UnsafeRun(mapIO).Get().Get())
 
 }
+
+func TestRIOWithContextCancelsAttemptContext(t *testing.T) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	stopped := make(chan error, 1)
+	io := rio.AttemptContext(func(ctx context.Context) *result.Result[int] {
+		<-ctx.Done()
+		stopped <- ctx.Err()
+		return result.OfError[int](ctx.Err())
+	})
+
+	res := rio.UnsafeRun(rio.WithContext(ctx, io))
+	assert.ErrorIs(t, res.Failure(), context.DeadlineExceeded)
+
+	select {
+	case err := <-stopped:
+		assert.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("AttemptContext not cancelled")
+	}
+}
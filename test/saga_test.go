@@ -0,0 +1,172 @@
+package test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mobilemindtech/go-io/io"
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/mobilemindtech/go-io/saga"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/mobilemindtech/go-io/types/unit"
+	"github.com/stretchr/testify/assert"
+)
+
+var errPayment = errors.New("payment refused")
+
+func TestRioSagaCompensate(t *testing.T) {
+	var undone []string
+	s := rio.NewSaga()
+
+	reserve := func(item string) *rio.IO[string] {
+		return rio.SagaStep(s, rio.Pure("res-"+item).As("reserve "+item), func(id string) *rio.IO[*unit.Unit] {
+			undone = append(undone, id)
+			return rio.Pure(unit.OfUnit())
+		})
+	}
+
+	order := rio.FlatMap(reserve("room"), func(room string) *rio.IO[string] {
+		return rio.FlatMap(reserve("car"), func(car string) *rio.IO[string] {
+			return rio.Error[string](errPayment)
+		})
+	})
+
+	res := rio.UnsafeRun(rio.RunSaga(s, order))
+
+	assert.Equal(t, []string{"res-car", "res-room"}, undone)
+	var sagaErr *saga.Error
+	assert.ErrorAs(t, res.Failure(), &sagaErr)
+	assert.ErrorIs(t, res.Failure(), errPayment)
+	assert.Equal(t, []string{"reserve car", "reserve room"}, sagaErr.Compensated)
+	assert.Empty(t, sagaErr.Failures)
+}
+
+func TestRioSagaSuccess(t *testing.T) {
+	s := rio.NewSaga()
+	compensated := false
+
+	step := rio.SagaStep(s, rio.Pure(10), func(i int) *rio.IO[*unit.Unit] {
+		compensated = true
+		return rio.Pure(unit.OfUnit())
+	})
+
+	res := rio.UnsafeRun(rio.RunSaga(s, rio.Map(step, func(i int) int { return i * 2 })))
+
+	assert.Equal(t, 20, res.Get().Get())
+	assert.False(t, compensated)
+}
+
+func TestRioSagaCompensationRetry(t *testing.T) {
+	attempts := 0
+	s := rio.NewSaga().WithRetry(saga.NewRetryPolicy(3, time.Millisecond).WithBackoff(2))
+
+	step := rio.SagaStep(s, rio.Pure(1).As("charge"), func(i int) *rio.IO[*unit.Unit] {
+		attempts++
+		return rio.Error[*unit.Unit](errors.New("refund unavailable"))
+	})
+
+	res := rio.UnsafeRun(rio.RunSaga(s, rio.FlatMap(step, func(i int) *rio.IO[int] {
+		return rio.Error[int](errPayment)
+	})))
+
+	assert.Equal(t, 3, attempts)
+	var sagaErr *saga.Error
+	assert.ErrorAs(t, res.Failure(), &sagaErr)
+	assert.Equal(t, "charge", sagaErr.Failures[0].Step)
+	assert.Equal(t, 3, sagaErr.Failures[0].Attempts)
+	assert.Equal(t,
+		"saga failed: payment refused; compensation of charge failed after 3 attempts: refund unavailable",
+		sagaErr.Error())
+}
+
+func TestRioSagaConcurrentRuns(t *testing.T) {
+	var mu sync.Mutex
+	var undone []string
+	s := rio.NewSaga()
+
+	reserve := func(item string) *rio.IO[string] {
+		return rio.SagaStep(s, rio.Pure(item).As("reserve "+item), func(id string) *rio.IO[*unit.Unit] {
+			mu.Lock()
+			undone = append(undone, id)
+			mu.Unlock()
+			return rio.Pure(unit.OfUnit())
+		})
+	}
+
+	reserved := make(chan struct{})
+	release := make(chan struct{})
+	failed := make(chan *result.Result[*option.Option[string]])
+
+	go func() {
+		failed <- rio.UnsafeRun(rio.RunSaga(s, rio.FlatMap(reserve("room"), func(room string) *rio.IO[string] {
+			return rio.Attempt(func() *result.Result[string] {
+				close(reserved)
+				<-release
+				return result.OfError[string](errPayment)
+			})
+		})))
+	}()
+
+	<-reserved
+	ok := rio.UnsafeRun(rio.RunSaga(s, reserve("car")))
+	close(release)
+	res := <-failed
+
+	assert.Equal(t, "car", ok.Get().Get())
+	var sagaErr *saga.Error
+	assert.ErrorAs(t, res.Failure(), &sagaErr)
+	assert.Equal(t, []string{"reserve room"}, sagaErr.Compensated)
+	assert.Equal(t, []string{"room"}, undone)
+}
+
+func TestRioSagaStepOutsideRunSaga(t *testing.T) {
+	var undone []string
+	s := rio.NewSaga()
+
+	step := rio.SagaStep(s, rio.Pure("room").As("reserve"), func(id string) *rio.IO[*unit.Unit] {
+		undone = append(undone, id)
+		return rio.Pure(unit.OfUnit())
+	})
+
+	assert.Equal(t, "room", rio.UnsafeRun(step).Get().Get())
+	assert.Equal(t, "room", rio.UnsafeRun(step).Get().Get())
+
+	res := rio.UnsafeRun(rio.RunSaga(s, rio.FlatMap(step, func(string) *rio.IO[int] {
+		return rio.Error[int](errPayment)
+	})))
+
+	var sagaErr *saga.Error
+	assert.ErrorAs(t, res.Failure(), &sagaErr)
+	assert.Equal(t, []string{"reserve"}, sagaErr.Compensated)
+	assert.Equal(t, []string{"room"}, undone)
+}
+
+func TestIOAppSaga(t *testing.T) {
+	var undone []string
+
+	res := io.IOApp[int]().
+		Step(io.IO[string]().As("room").Pure(io.PureVal("room-1")),
+			func(st *state.State) error {
+				undone = append(undone, state.VarOf[string](st, "room"))
+				return nil
+			}).
+		Effect(io.IO[int]().As("price").Pure(io.PureVal(100))).
+		Step(io.IO[string]().As("car").Pure(io.PureVal("car-1")),
+			func(st *state.State) error {
+				undone = append(undone, state.VarOf[string](st, "car"))
+				return nil
+			}).
+		Effect(io.IO[int]().As("payment").
+			Attempt(io.Attempt(func() *result.Result[int] { return result.OfError[int](errPayment) }))).
+		UnsafeRun()
+
+	assert.Equal(t, []string{"car-1", "room-1"}, undone)
+	assert.ErrorIs(t, res.Failure(), errPayment)
+	var sagaErr *saga.Error
+	assert.ErrorAs(t, res.Failure(), &sagaErr)
+	assert.Equal(t, []string{"car", "room"}, sagaErr.Compensated)
+}
@@ -0,0 +1,248 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/mobilemindtech/go-io/scheduler"
+	"github.com/mobilemindtech/go-io/types/unit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronNext(t *testing.T) {
+	saturday := time.Date(2026, 10, 17, 10, 30, 0, 0, time.UTC)
+
+	next := scheduler.MustCron("*/15 9-17 * * mon-fri").Next(saturday)
+	assert.Equal(t, time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC), next)
+
+	next = scheduler.MustCron("*/15 9-17 * * mon-fri").Next(next)
+	assert.Equal(t, time.Date(2026, 10, 19, 9, 15, 0, 0, time.UTC), next)
+
+	next = scheduler.MustCron("@daily").Next(saturday)
+	assert.Equal(t, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), next)
+
+	// day of month or day of week
+	next = scheduler.MustCron("0 0 13 * 5").Next(saturday)
+	assert.Equal(t, time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC), next)
+
+	next = scheduler.MustCron("30 3 29 feb *").Next(saturday)
+	assert.Equal(t, time.Date(2028, 2, 29, 3, 30, 0, 0, time.UTC), next)
+
+	next = scheduler.MustCron("@every 90s").Next(saturday)
+	assert.Equal(t, saturday.Add(90*time.Second), next)
+}
+
+func TestCronErrors(t *testing.T) {
+	_, err := scheduler.Cron("* * * *")
+	assert.EqualError(t, err, `cron expression "* * * *": expected 5 fields, but has 4`)
+
+	_, err = scheduler.Cron("61 * * * *")
+	assert.EqualError(t, err, `cron expression "61 * * * *": minute: invalid value "61", expected 0-59`)
+
+	_, err = scheduler.Cron("*/0 * * * *")
+	assert.ErrorContains(t, err, "invalid step")
+
+	_, err = scheduler.Cron("@every never")
+	assert.ErrorContains(t, err, "invalid interval")
+}
+
+func TestSchedulerEvery(t *testing.T) {
+	clock := scheduler.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	runs := make(chan struct{}, 10)
+
+	job := scheduler.NewJob("poll", scheduler.Every(time.Minute), rio.PureF(func() *unit.Unit {
+		runs <- struct{}{}
+		return unit.OfUnit()
+	}))
+
+	s := scheduler.New().WithClock(clock).Add(job).Start()
+
+	for i := 0; i < 3; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+		<-runs
+	}
+
+	assert.Nil(t, s.Stop(context.Background()))
+	assert.Len(t, runs, 0)
+}
+
+func blockingJob(started chan<- struct{}, release <-chan struct{}, runs *atomic.Int32) *rio.IO[*unit.Unit] {
+	return rio.PureF(func() *unit.Unit {
+		runs.Add(1)
+		started <- struct{}{}
+		<-release
+		return unit.OfUnit()
+	})
+}
+
+func TestSchedulerOverlap(t *testing.T) {
+	for _, tc := range []struct {
+		overlap scheduler.Overlap
+		running int32
+		runs    int32
+	}{
+		{scheduler.Skip, 1, 1},
+		{scheduler.Queue, 1, 2},
+		{scheduler.Concurrent, 2, 2},
+	} {
+		clock := scheduler.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		started := make(chan struct{}, 10)
+		release := make(chan struct{})
+		var runs atomic.Int32
+
+		job := scheduler.NewJob("sync", scheduler.Every(time.Minute), blockingJob(started, release, &runs)).
+			WithOverlap(tc.overlap)
+		s := scheduler.New().WithClock(clock).Add(job).Start()
+
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+		<-started
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+		clock.BlockUntil(1)
+
+		if tc.overlap == scheduler.Concurrent {
+			<-started
+		}
+		assert.Equal(t, tc.running, runs.Load(), "overlap %v", tc.overlap)
+
+		release <- struct{}{}
+		if tc.overlap == scheduler.Queue {
+			<-started
+		}
+		close(release)
+
+		assert.Nil(t, s.Stop(context.Background()))
+		assert.Equal(t, tc.runs, runs.Load(), "overlap %v", tc.overlap)
+	}
+}
+
+func TestSchedulerRecover(t *testing.T) {
+	clock := scheduler.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	failed := make(chan error, 1)
+	panicked := make(chan error, 1)
+
+	failing := scheduler.NewJob("failing", scheduler.Every(time.Minute), rio.Attempt(func() *result.Result[*unit.Unit] {
+		return result.OfError[*unit.Unit](errors.New("db down"))
+	})).Recover(func(err error) { failed <- err })
+	panicking := scheduler.NewJob("panicking", scheduler.Every(time.Minute), rio.PureF(func() *unit.Unit {
+		panic("boom")
+	})).Recover(func(err error) { panicked <- err })
+
+	s := scheduler.New().WithClock(clock).Add(failing, panicking).Start()
+
+	clock.BlockUntil(2)
+	clock.Advance(time.Minute)
+	assert.EqualError(t, <-failed, "db down")
+	assert.Error(t, <-panicked)
+
+	assert.Nil(t, s.Stop(context.Background()))
+}
+
+func TestSchedulerStopWaitsRunningJobs(t *testing.T) {
+	clock := scheduler.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	var runs atomic.Int32
+
+	s := scheduler.New().WithClock(clock).
+		Add(scheduler.NewJob("export", scheduler.MustCron("0 * * * *"), blockingJob(started, release, &runs))).
+		Start()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Hour)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, s.Stop(ctx), context.DeadlineExceeded)
+
+	close(release)
+	assert.Nil(t, s.Stop(context.Background()))
+	assert.Equal(t, int32(1), runs.Load())
+}
+
+func TestSchedulerStopCancelsRunningJobs(t *testing.T) {
+	clock := scheduler.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	var done atomic.Bool
+	started := make(chan struct{})
+	failures := make(chan error, 1)
+
+	sleep := rio.FlatMap(rio.PureF(func() *unit.Unit {
+		close(started)
+		return unit.OfUnit()
+	}), func(*unit.Unit) *rio.IO[*unit.Unit] { return rio.Sleep(time.Hour) })
+
+	job := scheduler.NewJob("export", scheduler.Every(time.Minute),
+		rio.FlatMap(sleep, func(u *unit.Unit) *rio.IO[*unit.Unit] {
+			return rio.PureF(func() *unit.Unit {
+				done.Store(true)
+				return u
+			})
+		})).
+		Recover(func(err error) { failures <- err })
+
+	s := scheduler.New().WithClock(clock).Add(job).Start()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, s.Stop(ctx), context.DeadlineExceeded)
+
+	assert.ErrorIs(t, <-failures, context.Canceled)
+	assert.Nil(t, s.Stop(context.Background()))
+	assert.False(t, done.Load())
+}
+
+func TestSchedulerJitter(t *testing.T) {
+	clock := scheduler.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	runs := make(chan time.Time, 10)
+	jitter := 30 * time.Second
+
+	job := scheduler.NewJob("poll", scheduler.Every(time.Minute), rio.PureF(func() *unit.Unit {
+		runs <- clock.Now()
+		return unit.OfUnit()
+	})).WithJitter(jitter)
+
+	s := scheduler.New().WithClock(clock).Add(job).Start()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	clock.BlockUntil(2) // next schedule and jitter wait
+	assert.Len(t, runs, 0)
+
+	clock.Advance(jitter - time.Nanosecond)
+	ranAt := <-runs
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC).Add(jitter-time.Nanosecond), ranAt)
+
+	assert.Nil(t, s.Stop(context.Background()))
+}
+
+func TestSchedulerStopDuringJitter(t *testing.T) {
+	clock := scheduler.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	var runs atomic.Int32
+
+	job := scheduler.NewJob("poll", scheduler.Every(time.Minute), rio.PureF(func() *unit.Unit {
+		runs.Add(1)
+		return unit.OfUnit()
+	})).WithJitter(time.Hour)
+
+	s := scheduler.New().WithClock(clock).Add(job).Start()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	clock.BlockUntil(2)
+
+	assert.Nil(t, s.Stop(context.Background()))
+	clock.Advance(time.Hour)
+	assert.Equal(t, int32(0), runs.Load())
+}
@@ -0,0 +1,111 @@
+package test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mobilemindtech/go-io/http/server"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/mobilemindtech/go-io/types/unit"
+	"github.com/mobilemindtech/go-io/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func newUserRouter() *server.Router {
+	return newUserRouterWith(&atomic.Bool{})
+}
+
+func newUserRouterWith(slowDone *atomic.Bool) *server.Router {
+	router := server.NewRouter()
+
+	server.Get(router, "/users/{id}", func(req *server.Request) *rio.IO[*server.ServerResponse[*Person]] {
+		return rio.FlatMap(
+			server.PathParamIO[int](req, "id"),
+			func(id int) *rio.IO[*server.ServerResponse[*Person]] {
+				if id == 0 {
+					return rio.Pure[*server.ServerResponse[*Person]](nil)
+				}
+				return server.OkIO(&Person{Name: "Ricardo", Age: id})
+			})
+	})
+
+	server.Post(router, "/users", func(req *server.Request) *rio.IO[*server.ServerResponse[*Person]] {
+		return server.MapCreated(
+			rio.AttemptThen(
+				server.BodyIO[*Person](req),
+				func(p *Person) *result.Result[*Person] {
+					if len(p.Name) == 0 {
+						return result.OfError[*Person](
+							validation.WithErrors(map[string]string{"name": "required"}).(*validation.Failure))
+					}
+					return result.OfValue(p)
+				}))
+	})
+
+	server.Get(router, "/slow", func(req *server.Request) *rio.IO[*server.ServerResponse[string]] {
+		return rio.FlatMap(rio.Sleep(200*time.Millisecond), func(*unit.Unit) *rio.IO[*server.ServerResponse[string]] {
+			return rio.PureF(func() *server.ServerResponse[string] {
+				slowDone.Store(true)
+				return server.Ok("done")
+			})
+		})
+	})
+
+	return router
+}
+
+func TestServerPathParam(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newUserRouter().ServeHTTP(rec, httptest.NewRequest("GET", "/users/37", nil))
+
+	assert.Equal(t, 200, rec.Code)
+	assert.JSONEq(t, `{"Name":"Ricardo","Age":37}`, rec.Body.String())
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+}
+
+func TestServerInvalidPathParam(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newUserRouter().ServeHTTP(rec, httptest.NewRequest("GET", "/users/abc", nil))
+
+	assert.Equal(t, 400, rec.Code)
+}
+
+func TestServerEmptyIsNotFound(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newUserRouter().ServeHTTP(rec, httptest.NewRequest("GET", "/users/0", nil))
+
+	assert.Equal(t, 404, rec.Code)
+}
+
+func TestServerBodyAndValidation(t *testing.T) {
+	router := newUserRouter()
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("POST", "/users", strings.NewReader(`{"Name":"Ricardo","Age":37}`)))
+	assert.Equal(t, 201, rec.Code)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("POST", "/users", strings.NewReader(`{"Age":37}`)))
+	assert.Equal(t, nethttp.StatusUnprocessableEntity, rec.Code)
+	assert.JSONEq(t, `{"message":"validation error","errors":{"name":"required"}}`, rec.Body.String())
+}
+
+func TestServerRequestCancellation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	slowDone := &atomic.Bool{}
+	rec := httptest.NewRecorder()
+	newUserRouterWith(slowDone).ServeHTTP(rec, httptest.NewRequest("GET", "/slow", nil).WithContext(ctx))
+
+	assert.Equal(t, nethttp.StatusGatewayTimeout, rec.Code)
+
+	time.Sleep(300 * time.Millisecond)
+	assert.False(t, slowDone.Load(), "handler must stop when the request is cancelled")
+}
@@ -0,0 +1,46 @@
+package flow
+
+import (
+	"strconv"
+
+	"github.com/mobilemindtech/go-io/io"
+	"github.com/mobilemindtech/go-io/types"
+)
+
+func Valid() *types.IO[string] {
+	return io.IO[string](
+		io.PureVal(1),
+		io.Map(func(i int) string { return strconv.Itoa(i) }),
+		io.Filter(func(s string) bool { return s != "" }))
+}
+
+func MapMismatch() *types.IO[string] {
+	return io.IO[string](
+		io.PureVal(1),
+		io.Map(func(s string) string { return s })) // want IOMap string IOPure int
+}
+
+func BuilderMismatch() *types.IO[int] {
+	return io.IO[int]().
+		Pure(io.PureVal("a")).
+		As("builder").
+		Filter(io.Filter(func(i int) bool { return i > 0 })). // want IOFilter int IOPure string
+		Map(io.Map(func(i int) int { return i }))
+}
+
+func PureRestart() *types.IO[int] {
+	return io.IO[int](
+		io.PureVal("a"),
+		io.PureVal(1),
+		io.Map(func(i int) int { return i }))
+}
+
+func FlatMapIO() *types.IO[int] {
+	return io.IO[int](
+		io.PureVal("a"),
+		io.FlatMap1(io.IO[int](io.PureVal(1)), func(i int) *types.IO[int] { return io.IO[int](io.PureVal(i)) }))
+}
+
+func Unknown(effs ...types.IOEffect) *types.IO[int] {
+	return io.IO[int](effs...).Map(io.Map(func(s string) int { return len(s) }))
+}
@@ -0,0 +1,156 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/io"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/mobilemindtech/go-io/trace"
+	"github.com/stretchr/testify/assert"
+)
+
+func withTracer(t *testing.T) *trace.InMemoryExporter {
+	exporter := trace.NewInMemoryExporter()
+	trace.SetTracer(trace.NewTracer(exporter))
+	t.Cleanup(func() { trace.SetTracer(nil) })
+	return exporter
+}
+
+func TestTraceRIO(t *testing.T) {
+	exporter := withTracer(t)
+
+	res := rio.Map(rio.Pure(2), func(i int) int { return i * 2 }).UnsafeRun()
+	assert.Equal(t, 4, res.UnsafeGet())
+
+	mapSpan := exporter.Find("Map").Get()
+	pureSpan := exporter.Find("Pure").Get()
+	assert.Equal(t, mapSpan.Id, pureSpan.ParentId)
+	assert.Equal(t, int64(0), mapSpan.ParentId)
+	assert.Equal(t, "int", mapSpan.Attr(trace.AttrIOType))
+	assert.Equal(t, false, mapSpan.Attr(trace.AttrEmpty))
+	assert.True(t, strings.HasSuffix(pureSpan.Attr(trace.AttrFilepath).(string), "trace_test.go"))
+	assert.Greater(t, pureSpan.Attr(trace.AttrLineno), 0)
+	assert.False(t, mapSpan.End.Before(pureSpan.End))
+}
+
+func TestTraceRIOError(t *testing.T) {
+	exporter := withTracer(t)
+
+	boom := errors.New("boom")
+	rio.Map(rio.Attempt(func() *result.Result[int] { return result.OfError[int](boom) }), func(i int) int { return i }).
+		As("LoadCount").
+		UnsafeRun()
+
+	span := exporter.Find("LoadCount").Get()
+	assert.ErrorIs(t, span.Err, boom)
+	assert.Len(t, exporter.Children(span), 1)
+}
+
+func TestTraceIOEffects(t *testing.T) {
+	exporter := withTracer(t)
+
+	res := io.IO[int]().
+		Pure(io.PureVal(2)).
+		Map(io.Map(func(i int) int { return i * 3 })).
+		UnsafeRun()
+	assert.Equal(t, 6, res.Get().Get())
+
+	spans := exporter.Spans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "IOPure", spans[0].Name)
+	assert.Equal(t, "IOMap", spans[1].Name)
+	assert.True(t, strings.HasSuffix(spans[1].Attr(trace.AttrFilepath).(string), "trace_test.go"))
+	assert.Greater(t, spans[1].Attr(trace.AttrLineno), spans[0].Attr(trace.AttrLineno))
+
+	exporter.Reset()
+	trace.SetTracer(nil)
+	io.IO[int]().Pure(io.PureVal(1)).UnsafeRun()
+	assert.Empty(t, exporter.Spans())
+}
+
+type otelSpanMock struct {
+	name  string
+	attrs map[string]any
+	ended bool
+}
+
+func (this *otelSpanMock) SetAttribute(key string, value any) { this.attrs[key] = value }
+func (this *otelSpanMock) RecordError(err error)              {}
+func (this *otelSpanMock) End()                               { this.ended = true }
+
+type parentKey struct{}
+
+type otelTracerMock struct {
+	parents map[string]string
+	spans   []*otelSpanMock
+}
+
+func (this *otelTracerMock) Start(ctx context.Context, name string) (context.Context, trace.OtelSpan) {
+	parent, _ := ctx.Value(parentKey{}).(string)
+	this.parents[name] = parent
+	span := &otelSpanMock{name: name, attrs: map[string]any{}}
+	this.spans = append(this.spans, span)
+	return context.WithValue(ctx, parentKey{}, name), span
+}
+
+func TestTraceOtelAdapter(t *testing.T) {
+	tracer := &otelTracerMock{parents: map[string]string{}}
+	trace.SetTracer(trace.NewOtelTracer(context.Background(), tracer))
+	t.Cleanup(func() { trace.SetTracer(nil) })
+
+	rio.Map(rio.Pure(1), func(i int) int { return i }).UnsafeRun()
+
+	assert.Equal(t, "", tracer.parents["Map"])
+	assert.Equal(t, "Map", tracer.parents["Pure"])
+	for _, span := range tracer.spans {
+		assert.True(t, span.ended)
+		assert.Equal(t, "int", span.attrs[trace.AttrIOType])
+	}
+}
+
+func TestTraceConcurrentRuns(t *testing.T) {
+	exporter := withTracer(t)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+
+	load := rio.Attempt(func() *result.Result[int] {
+		close(started)
+		<-release
+		return result.OfValue(1)
+	}).As("Load")
+
+	go func() {
+		defer close(done)
+		rio.Map(load, func(i int) int { return i }).As("First").UnsafeRun()
+	}()
+
+	<-started
+	rio.Map(rio.Pure(2), func(i int) int { return i }).As("Second").UnsafeRun()
+	close(release)
+	<-done
+
+	first := exporter.Find("First").Get()
+	second := exporter.Find("Second").Get()
+	assert.Equal(t, int64(0), first.ParentId)
+	assert.Equal(t, int64(0), second.ParentId)
+	assert.Equal(t, first.Id, exporter.Find("Load").Get().ParentId)
+	assert.Equal(t, second.Id, exporter.Find("Pure").Get().ParentId)
+}
+
+func TestTraceParMapParent(t *testing.T) {
+	exporter := withTracer(t)
+
+	rio.ParMap2(rio.Pure(1).As("Left"), rio.Pure(2).As("Right"), func(a, b int) int { return a + b }).
+		As("Sum").
+		UnsafeRun()
+
+	sum := exporter.Find("Sum").Get()
+	assert.Equal(t, sum.Id, exporter.Find("Left").Get().ParentId)
+	assert.Equal(t, sum.Id, exporter.Find("Right").Get().ParentId)
+}
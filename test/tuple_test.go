@@ -0,0 +1,105 @@
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/option"
+	"github.com/mobilemindtech/go-io/pipeline"
+	"github.com/mobilemindtech/go-io/result"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/mobilemindtech/go-io/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTupleAccessors(t *testing.T) {
+	tp := tuple.Of3("Ana", 30, true)
+
+	assert.Equal(t, "Ana", tp.V1())
+	assert.Equal(t, 30, tp.V2())
+	assert.True(t, tp.V3())
+	assert.Equal(t, []any{"Ana", 30, true}, tp.Slice())
+
+	name, age, _ := tp.Values()
+	assert.Equal(t, "Ana", name)
+	assert.Equal(t, 30, age)
+}
+
+func TestTupleJson(t *testing.T) {
+	data, err := json.Marshal(tuple.Of2("Ana", 30))
+	assert.NoError(t, err)
+	assert.Equal(t, `["Ana",30]`, string(data))
+
+	data, err = json.Marshal(*tuple.Of2("Ana", 30))
+	assert.NoError(t, err)
+	assert.Equal(t, `["Ana",30]`, string(data))
+
+	data, err = json.Marshal(struct{ Pair tuple.T2[string, int] }{*tuple.Of2("Ana", 30)})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Pair":["Ana",30]}`, string(data))
+
+	var tp *tuple.T2[string, int]
+	assert.NoError(t, json.Unmarshal([]byte(`["Bia", 25]`), &tp))
+	assert.Equal(t, tuple.Of2("Bia", 25), tp)
+
+	assert.EqualError(t, json.Unmarshal([]byte(`["Bia"]`), &tp),
+		"tuple expect 2 values, but JSON array has 1")
+	assert.Error(t, json.Unmarshal([]byte(`["Bia", "25"]`), &tp))
+}
+
+func TestOptionZip(t *testing.T) {
+	assert.Equal(t, option.Some(tuple.Of2("Ana", 30)), option.Zip(option.Some("Ana"), option.Some(30)))
+	assert.True(t, option.Zip(option.Some("Ana"), option.None[int]()).IsEmpty())
+}
+
+func TestResultZip(t *testing.T) {
+	assert.Equal(t, tuple.Of2("Ana", 30), result.Zip(result.OfValue("Ana"), result.OfValue(30)).Get())
+
+	failed := result.Zip(result.OfValue("Ana"), result.OfError[int](errors.New("fail")))
+	assert.EqualError(t, failed.Failure(), "fail")
+}
+
+func TestRioUnzip(t *testing.T) {
+	runs := 0
+	zipped := rio.PureF(func() *tuple.T2[string, int] {
+		runs++
+		return tuple.Of2("Ana", 30)
+	})
+
+	name, age := rio.Unzip(zipped)
+	assert.Equal(t, "Ana", rio.UnsafeRun(name).Get().Get())
+	assert.Equal(t, 30, rio.UnsafeRun(age).Get().Get())
+	assert.Equal(t, 1, runs)
+
+	failedA, failedB := rio.Unzip(rio.Error[*tuple.T2[string, int]](errors.New("fail")))
+	assert.EqualError(t, rio.UnsafeRun(failedA).GetError(), "fail")
+	assert.EqualError(t, rio.UnsafeRun(failedB).GetError(), "fail")
+}
+
+func TestPipelineTuple(t *testing.T) {
+	res := pipeline.New[string]().
+		Next(func() *tuple.T2[string, int] {
+			return tuple.Of2("Ana", 30)
+		}).
+		Next(func(name string, age int) string {
+			return fmt.Sprintf("%v %v", name, age)
+		}).
+		UnsafeRun()
+
+	assert.Equal(t, option.Some("Ana 30"), res.Get())
+}
+
+func TestPipelineNamedTuple(t *testing.T) {
+	p := pipeline.New[int]().
+		Next(func() state.Tuples {
+			return state.Named(tuple.Of2("Ana", 30), "name", "age")
+		}).
+		Next(func(age int) int {
+			return age + 1
+		})
+
+	assert.Equal(t, option.Some(31), p.UnsafeRun().Get())
+}
@@ -0,0 +1,102 @@
+package test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/pipeline"
+	"github.com/mobilemindtech/go-io/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypedPipeline(t *testing.T) {
+	count := state.NewKey[int]("count")
+	label := state.NewKey[string]("label")
+	out := state.NewKey[string]("out")
+
+	res := pipeline.NewTyped(out).
+		Then(
+			pipeline.Step0(count, func() (int, error) { return 2, nil }),
+			pipeline.Step1(count, label, func(i int) (string, error) { return strconv.Itoa(i * 2), nil }),
+			pipeline.Step2(count, label, out, func(i int, s string) (string, error) {
+				return strconv.Itoa(i) + ":" + s, nil
+			}),
+		).UnsafeRun()
+
+	assert.Equal(t, "2:4", res.Get().Get())
+}
+
+func TestTypedPipelineStepError(t *testing.T) {
+	count := state.NewKey[int]("count")
+	out := state.NewKey[int]("out")
+
+	res := pipeline.NewTyped(out).
+		Then(
+			pipeline.Step0(count, func() (int, error) { return 0, errors.New("no count") }),
+			pipeline.Step1(count, out, func(i int) (int, error) { return i, nil }),
+		).UnsafeRun()
+
+	assert.Equal(t, "no count", res.Failure().Error())
+}
+
+func TestTypedPipelineMissingVar(t *testing.T) {
+	count := state.NewKey[int]("count")
+	out := state.NewKey[int]("out")
+
+	res := pipeline.NewTyped(out).
+		Then(pipeline.Step1(count, out, func(i int) (int, error) { return i, nil })).
+		UnsafeRun()
+
+	assert.Equal(t, "var count not found on state", res.Failure().Error())
+
+	st := state.NewState()
+	st.SetVar("count", "two")
+	res = pipeline.NewTyped(out).
+		WithState(st).
+		Then(pipeline.Step1(count, out, func(i int) (int, error) { return i, nil })).
+		UnsafeRun()
+
+	assert.Equal(t, "var count type is string, but expected int", res.Failure().Error())
+}
+
+func TestTypedPipelineTypeKey(t *testing.T) {
+	res := pipeline.NewTyped(state.TypeKey[string]()).
+		Then(
+			pipeline.Step0(state.TypeKey[int](), func() (int, error) { return 10, nil }),
+			pipeline.Step1(state.TypeKey[int](), state.TypeKey[string](), func(i int) (string, error) {
+				return strconv.Itoa(i), nil
+			}),
+		).UnsafeRun()
+
+	assert.Equal(t, "10", res.Get().Get())
+}
+
+func TestTypedStepOnPipeline(t *testing.T) {
+	label := pipeline.Step1(state.TypeKey[int](), state.NewKey[string]("label"), func(i int) (string, error) {
+		return "n=" + strconv.Itoa(i*2), nil
+	})
+
+	res := pipeline.New[string]().
+		Next(func() int { return 3 }).
+		Step(label).
+		Next(func(s string) string { return s + "!" }).
+		UnsafeRun()
+
+	assert.Equal(t, "n=6!", res.Get().Get())
+
+	typed := pipeline.NewTyped(state.NewKey[int]("size")).
+		Then(pipeline.Step1(state.NewKey[string]("label"), state.NewKey[int]("size"), func(s string) (int, error) {
+			return len(s), nil
+		}))
+
+	res2 := pipeline.New[int]().
+		Next(func() int { return 3 }).
+		Step(label).
+		Suspension(typed).
+		UnsafeRun()
+
+	assert.Equal(t, 3, res2.Get().Get())
+	assert.Equal(t, []string{"label"}, typed.Steps()[0].Reads())
+	assert.Equal(t, "size", typed.Steps()[0].Writes())
+}
@@ -0,0 +1,194 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mobilemindtech/go-io/io"
+	"github.com/mobilemindtech/go-io/rio"
+	"github.com/mobilemindtech/go-io/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type SignupAddress struct {
+	Street string `json:"street" validate:"required"`
+	Number int    `json:"number" validate:"min=1,max=99999"`
+}
+
+type Signup struct {
+	Name      string           `json:"name" validate:"required,min=3,max=20"`
+	Email     string           `json:"email" validate:"required,email"`
+	Plan      string           `json:"plan" validate:"oneof=free pro"`
+	Code      string           `json:"code" validate:"regex=^[A-Z]{2},[0-9]+$"`
+	Address   *SignupAddress   `json:"address" validate:"required"`
+	Others    []*SignupAddress `json:"others"`
+	Tags      []string         `validate:"max=2"`
+	ignored   string           `validate:"required"`
+	CreatedBy string           `json:"-" validate:"min=2"`
+}
+
+func validateAddress(a *SignupAddress) *validation.V[*SignupAddress] {
+	return validation.Map2(
+		validation.Field("street", a.Street, validation.Required[string]()),
+		validation.Field("number", a.Number, validation.Range(1, 99999)),
+		func(street string, number int) *SignupAddress {
+			return &SignupAddress{Street: street, Number: number}
+		})
+}
+
+func TestValidationApplicative(t *testing.T) {
+	v := validation.Map3(
+		validation.Field("name", "Ri", validation.Required[string](), validation.MinLen(3), validation.Regex("^[a-z]+$")),
+		validation.Field("email", "ricardo.mail.com", validation.Email()),
+		validateAddress(&SignupAddress{Number: 0}).At("address"),
+		func(name string, email string, address *SignupAddress) *Signup {
+			return &Signup{Name: name, Email: email, Address: address}
+		})
+
+	assert.True(t, v.IsInvalid())
+	assert.Len(t, v.Errors(), 5)
+	assert.Equal(t, map[string]string{
+		"name":           "must have at least 3 characters; must match ^[a-z]+$",
+		"email":          "must be a valid email",
+		"address.street": "is required",
+		"address.number": "must be between 1 and 99999",
+	}, v.Failure().Errors)
+
+	valid := validation.Map2(
+		validation.Field("name", "ricardo", validation.MinLen(3)),
+		validation.Field("plan", "pro", validation.OneOf("free", "pro")),
+		func(name string, plan string) string { return name + ":" + plan })
+	assert.True(t, valid.IsValid())
+	assert.Equal(t, "ricardo:pro", valid.Get())
+	assert.Equal(t, "ricardo:pro", valid.ToResult().Get())
+}
+
+func TestValidationSequenceAndOptional(t *testing.T) {
+	addresses := []*SignupAddress{{Street: "a", Number: 1}, {Number: 2}, {Street: "c"}}
+	var vs []*validation.V[*SignupAddress]
+	for i, a := range addresses {
+		vs = append(vs, validateAddress(a).At(fmt.Sprintf("[%v]", i)).At("others"))
+	}
+	seq := validation.Sequence(vs)
+	assert.True(t, seq.IsInvalid())
+	assert.Equal(t, "others[1].street", seq.Errors()[0].Path)
+	assert.Equal(t, "others[2].number", seq.Errors()[1].Path)
+
+	email := validation.Field("email", "", validation.Optional(validation.Email()))
+	assert.True(t, email.IsValid())
+}
+
+func TestValidationStruct(t *testing.T) {
+	v := validation.Struct(&Signup{
+		Name:      "Ricardo",
+		Email:     "ricardo@mail.com",
+		Plan:      "gold",
+		Code:      "ab,1",
+		Others:    []*SignupAddress{{Street: "main", Number: 100000}},
+		Tags:      []string{"a", "b", "c"},
+		CreatedBy: "x",
+	})
+
+	assert.Equal(t, map[string]string{
+		"plan":             "must be one of [free pro]",
+		"code":             "must match ^[A-Z]{2},[0-9]+$",
+		"address":          "is required",
+		"others[0].number": "must be at most 99999",
+		"Tags":             "must have at most 2 items",
+		"CreatedBy":        "must have at least 2 characters",
+	}, v.Failure().Errors)
+
+	valid := validation.Struct(Signup{
+		Name:    "Ricardo",
+		Email:   "ricardo@mail.com",
+		Code:    "AB,12",
+		Address: &SignupAddress{Street: "main", Number: 10},
+	})
+	assert.True(t, valid.IsValid())
+}
+
+type BadRules struct {
+	Name  string `json:"name" validate:"unique"`
+	Age   int    `json:"age" validate:"min=ten"`
+	Admin bool   `json:"admin" validate:"max=1"`
+	Ok    string `json:"ok" validate:"min=1"`
+}
+
+type TreeNode struct {
+	Name     string      `json:"name" validate:"required"`
+	Parent   *TreeNode   `json:"parent"`
+	Children []*TreeNode `json:"children"`
+}
+
+func TestValidationStructInvalidRules(t *testing.T) {
+	v := validation.Struct(&BadRules{Ok: "x"})
+
+	assert.Equal(t, map[string]string{
+		"name":  "unknown validation rule unique",
+		"age":   "invalid min validation value ten",
+		"admin": "max validation is not supported for bool",
+	}, v.Failure().Errors)
+}
+
+func TestValidationStructCycle(t *testing.T) {
+	root := &TreeNode{Name: "root"}
+	child := &TreeNode{Parent: root}
+	root.Children = []*TreeNode{child}
+	root.Parent = root
+
+	v := validation.Struct(root)
+
+	assert.Equal(t, map[string]string{
+		"children[0].name": "is required",
+	}, v.Failure().Errors)
+}
+
+func TestValidationRIO(t *testing.T) {
+	res := rio.UnsafeRun(rio.Validate(rio.Pure(&SignupAddress{}), validateAddress))
+	assert.True(t, res.IsError())
+
+	var failure *validation.Failure
+	assert.True(t, errors.As(res.Failure(), &failure))
+	assert.Equal(t, "is required", failure.Errors["street"])
+
+	res = rio.UnsafeRun(rio.FromValidation(validateAddress(&SignupAddress{Street: "main", Number: 1})))
+	assert.Equal(t, "main", res.Get().Get().Street)
+}
+
+func TestValidationIOEffect(t *testing.T) {
+	rt := io.IOApp[*SignupAddress]()
+
+	res := rt.Effects(
+		io.IO[*SignupAddress]().
+			Pure(io.PureVal(&SignupAddress{Number: 0})).
+			Validate(io.Validate(validateAddress)).
+			Map(io.Map(func(a *SignupAddress) *SignupAddress {
+				a.Street = "changed"
+				return a
+			}))).UnsafeRun()
+
+	assert.True(t, res.IsError())
+	failure := validation.AsFailure(res.Failure()).Get()
+	assert.Len(t, failure.Fields, 2)
+	assert.Equal(t, "street", failure.Fields[0].Path)
+	assert.Equal(t, "must be between 1 and 99999", failure.Errors["number"])
+
+	res = io.IOApp[*SignupAddress]().Effects(
+		io.IO[*SignupAddress]().
+			Pure(io.PureVal(&SignupAddress{Street: "main", Number: 1})).
+			Validate(io.Validate(validateAddress))).UnsafeRun()
+	assert.True(t, res.IsOk())
+}
+
+func TestRecoverValidation(t *testing.T) {
+	recovered := rio.RecoverValidation(
+		rio.Pure(&SignupAddress{}).Validate(validateAddress),
+		func(failure *validation.Failure) *SignupAddress {
+			return &SignupAddress{Street: failure.Errors["street"]}
+		})
+	assert.Equal(t, "is required", rio.UnsafeRun(recovered).Get().Get().Street)
+
+	other := rio.RecoverValidation(rio.Errorf[int]("fail"), func(*validation.Failure) int { return 1 })
+	assert.True(t, rio.UnsafeRun(other).IsError())
+}
@@ -0,0 +1,57 @@
+package trace
+
+import (
+	"sync"
+
+	"github.com/mobilemindtech/go-io/option"
+)
+
+// InMemoryExporter keep finished spans in memory. Useful for tests
+type InMemoryExporter struct {
+	mu    sync.Mutex
+	spans []*SpanData
+}
+
+func NewInMemoryExporter() *InMemoryExporter {
+	return &InMemoryExporter{}
+}
+
+func (this *InMemoryExporter) Export(span *SpanData) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.spans = append(this.spans, span)
+}
+
+// Spans finished spans in end order
+func (this *InMemoryExporter) Spans() []*SpanData {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return append([]*SpanData{}, this.spans...)
+}
+
+// Find first finished span by name
+func (this *InMemoryExporter) Find(name string) *option.Option[*SpanData] {
+	for _, span := range this.Spans() {
+		if span.Name == name {
+			return option.Some(span)
+		}
+	}
+	return option.None[*SpanData]()
+}
+
+// Children finished spans of parent
+func (this *InMemoryExporter) Children(parent *SpanData) []*SpanData {
+	var children []*SpanData
+	for _, span := range this.Spans() {
+		if span.ParentId == parent.Id {
+			children = append(children, span)
+		}
+	}
+	return children
+}
+
+func (this *InMemoryExporter) Reset() {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.spans = nil
+}
@@ -0,0 +1,43 @@
+package trace
+
+import (
+	"context"
+)
+
+// OtelSpan span methods used by the OpenTelemetry adapter. Wrap an otel
+// trace.Span to convert attributes to attribute.KeyValue
+type OtelSpan interface {
+	SetAttribute(key string, value any)
+	RecordError(err error)
+	End()
+}
+
+// OtelTracer OpenTelemetry tracer shape, e.g. a wrapper of otel trace.Tracer
+type OtelTracer interface {
+	Start(ctx context.Context, name string) (context.Context, OtelSpan)
+}
+
+// OtelAdapter Tracer that delegate to an OpenTelemetry tracer. Span context of
+// the parent span is used as parent of new spans, and ctx for root spans
+type OtelAdapter struct {
+	tracer OtelTracer
+	ctx    context.Context
+}
+
+func NewOtelTracer(ctx context.Context, tracer OtelTracer) *OtelAdapter {
+	return &OtelAdapter{tracer: tracer, ctx: ctx}
+}
+
+func (this *OtelAdapter) Start(parent Span, name string) Span {
+	ctx := this.ctx
+	if p, ok := parent.(*otelSpan); ok {
+		ctx = p.ctx
+	}
+	ctx, s := this.tracer.Start(ctx, name)
+	return &otelSpan{OtelSpan: s, ctx: ctx}
+}
+
+type otelSpan struct {
+	OtelSpan
+	ctx context.Context
+}
@@ -0,0 +1,64 @@
+package trace
+
+import (
+	"sync"
+)
+
+// Attribute keys set by rio steps and ios effects
+const (
+	AttrFilepath = "code.filepath"
+	AttrLineno   = "code.lineno"
+	AttrIOName   = "io.name"
+	AttrIOType   = "io.type"
+	AttrEmpty    = "io.empty"
+)
+
+// Span single traced step
+type Span interface {
+	SetAttribute(key string, value any)
+	RecordError(err error)
+	End()
+}
+
+// Tracer start spans. Parent is nil for root spans
+type Tracer interface {
+	Start(parent Span, name string) Span
+}
+
+var (
+	mu     sync.RWMutex
+	global Tracer
+)
+
+// SetTracer set global tracer used by rio and IO effects. Nil disable tracing
+func SetTracer(tracer Tracer) {
+	mu.Lock()
+	defer mu.Unlock()
+	global = tracer
+}
+
+// Enabled report if a global tracer is set
+func Enabled() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return global != nil
+}
+
+// Start span as child of parent with global tracer. Return a no-op span when
+// tracing is disabled
+func Start(parent Span, name string) Span {
+	mu.RLock()
+	tracer := global
+	mu.RUnlock()
+	if tracer == nil {
+		return noopSpan{}
+	}
+	return tracer.Start(parent, name)
+}
+
+type noopSpan struct {
+}
+
+func (noopSpan) SetAttribute(string, any) {}
+func (noopSpan) RecordError(error)        {}
+func (noopSpan) End()                     {}
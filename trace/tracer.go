@@ -0,0 +1,80 @@
+package trace
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// SpanData finished span
+type SpanData struct {
+	Id         int64
+	ParentId   int64
+	Name       string
+	Attributes map[string]any
+	Err        error
+	Start      time.Time
+	End        time.Time
+}
+
+func (this *SpanData) Duration() time.Duration {
+	return this.End.Sub(this.Start)
+}
+
+// Attr get attribute value or nil
+func (this *SpanData) Attr(key string) any {
+	return this.Attributes[key]
+}
+
+// Exporter receive finished spans
+type Exporter interface {
+	Export(span *SpanData)
+}
+
+// DefaultTracer tracer that send finished spans to exporter
+type DefaultTracer struct {
+	exporter Exporter
+	lastId   atomic.Int64
+}
+
+func NewTracer(exporter Exporter) *DefaultTracer {
+	return &DefaultTracer{exporter: exporter}
+}
+
+func (this *DefaultTracer) Start(parent Span, name string) Span {
+	s := &span{
+		tracer: this,
+		data: &SpanData{
+			Id:         this.lastId.Add(1),
+			Name:       name,
+			Attributes: map[string]any{},
+			Start:      time.Now(),
+		},
+	}
+	if p, ok := parent.(*span); ok {
+		s.data.ParentId = p.data.Id
+	}
+	return s
+}
+
+type span struct {
+	tracer *DefaultTracer
+	data   *SpanData
+	ended  bool
+}
+
+func (this *span) SetAttribute(key string, value any) {
+	this.data.Attributes[key] = value
+}
+
+func (this *span) RecordError(err error) {
+	this.data.Err = err
+}
+
+func (this *span) End() {
+	if this.ended {
+		return
+	}
+	this.ended = true
+	this.data.End = time.Now()
+	this.tracer.exporter.Export(this.data)
+}
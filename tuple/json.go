@@ -0,0 +1,18 @@
+package tuple
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// unmarshalItems JSON array items, should have size items
+func unmarshalItems(data []byte, size int) ([]json.RawMessage, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	if len(items) != size {
+		return nil, fmt.Errorf("tuple expect %v values, but JSON array has %v", size, len(items))
+	}
+	return items, nil
+}
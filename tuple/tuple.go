@@ -0,0 +1,829 @@
+// Code generated by go-io/internal/gen. DO NOT EDIT.
+
+// Package tuple values of many types, encoded as JSON arrays. TupledN adapt
+// functions of N args to functions of a tuple
+package tuple
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// T2 tuple of 2 values
+type T2[A, B any] struct {
+	v1 A
+	v2 B
+}
+
+func Of2[A, B any](a A, b B) *T2[A, B] {
+	return &T2[A, B]{v1: a, v2: b}
+}
+
+func (this *T2[A, B]) V1() A {
+	return this.v1
+}
+
+func (this *T2[A, B]) V2() B {
+	return this.v2
+}
+
+// Values tuple values
+func (this *T2[A, B]) Values() (A, B) {
+	return this.v1, this.v2
+}
+
+// Slice tuple values as a slice
+func (this *T2[A, B]) Slice() []any {
+	return []any{this.v1, this.v2}
+}
+
+func (this *T2[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", this.v1, this.v2)
+}
+
+// MarshalJSON tuple as a JSON array
+func (this T2[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(this.Slice())
+}
+
+// UnmarshalJSON tuple from a JSON array of 2 values
+func (this *T2[A, B]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems(data, 2)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[0], &this.v1); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &this.v2); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tupled2 function of 2 args as a function of T2
+func Tupled2[A, B, T any](f func(A, B) T) func(*T2[A, B]) T {
+	return func(t *T2[A, B]) T {
+		return f(t.Values())
+	}
+}
+
+// T3 tuple of 3 values
+type T3[A, B, C any] struct {
+	v1 A
+	v2 B
+	v3 C
+}
+
+func Of3[A, B, C any](a A, b B, c C) *T3[A, B, C] {
+	return &T3[A, B, C]{v1: a, v2: b, v3: c}
+}
+
+func (this *T3[A, B, C]) V1() A {
+	return this.v1
+}
+
+func (this *T3[A, B, C]) V2() B {
+	return this.v2
+}
+
+func (this *T3[A, B, C]) V3() C {
+	return this.v3
+}
+
+// Values tuple values
+func (this *T3[A, B, C]) Values() (A, B, C) {
+	return this.v1, this.v2, this.v3
+}
+
+// Slice tuple values as a slice
+func (this *T3[A, B, C]) Slice() []any {
+	return []any{this.v1, this.v2, this.v3}
+}
+
+func (this *T3[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", this.v1, this.v2, this.v3)
+}
+
+// MarshalJSON tuple as a JSON array
+func (this T3[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(this.Slice())
+}
+
+// UnmarshalJSON tuple from a JSON array of 3 values
+func (this *T3[A, B, C]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems(data, 3)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[0], &this.v1); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &this.v2); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[2], &this.v3); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tupled3 function of 3 args as a function of T3
+func Tupled3[A, B, C, T any](f func(A, B, C) T) func(*T3[A, B, C]) T {
+	return func(t *T3[A, B, C]) T {
+		return f(t.Values())
+	}
+}
+
+// T4 tuple of 4 values
+type T4[A, B, C, D any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+}
+
+func Of4[A, B, C, D any](a A, b B, c C, d D) *T4[A, B, C, D] {
+	return &T4[A, B, C, D]{v1: a, v2: b, v3: c, v4: d}
+}
+
+func (this *T4[A, B, C, D]) V1() A {
+	return this.v1
+}
+
+func (this *T4[A, B, C, D]) V2() B {
+	return this.v2
+}
+
+func (this *T4[A, B, C, D]) V3() C {
+	return this.v3
+}
+
+func (this *T4[A, B, C, D]) V4() D {
+	return this.v4
+}
+
+// Values tuple values
+func (this *T4[A, B, C, D]) Values() (A, B, C, D) {
+	return this.v1, this.v2, this.v3, this.v4
+}
+
+// Slice tuple values as a slice
+func (this *T4[A, B, C, D]) Slice() []any {
+	return []any{this.v1, this.v2, this.v3, this.v4}
+}
+
+func (this *T4[A, B, C, D]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v)", this.v1, this.v2, this.v3, this.v4)
+}
+
+// MarshalJSON tuple as a JSON array
+func (this T4[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(this.Slice())
+}
+
+// UnmarshalJSON tuple from a JSON array of 4 values
+func (this *T4[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems(data, 4)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[0], &this.v1); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &this.v2); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[2], &this.v3); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[3], &this.v4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tupled4 function of 4 args as a function of T4
+func Tupled4[A, B, C, D, T any](f func(A, B, C, D) T) func(*T4[A, B, C, D]) T {
+	return func(t *T4[A, B, C, D]) T {
+		return f(t.Values())
+	}
+}
+
+// T5 tuple of 5 values
+type T5[A, B, C, D, E any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+	v5 E
+}
+
+func Of5[A, B, C, D, E any](a A, b B, c C, d D, e E) *T5[A, B, C, D, E] {
+	return &T5[A, B, C, D, E]{v1: a, v2: b, v3: c, v4: d, v5: e}
+}
+
+func (this *T5[A, B, C, D, E]) V1() A {
+	return this.v1
+}
+
+func (this *T5[A, B, C, D, E]) V2() B {
+	return this.v2
+}
+
+func (this *T5[A, B, C, D, E]) V3() C {
+	return this.v3
+}
+
+func (this *T5[A, B, C, D, E]) V4() D {
+	return this.v4
+}
+
+func (this *T5[A, B, C, D, E]) V5() E {
+	return this.v5
+}
+
+// Values tuple values
+func (this *T5[A, B, C, D, E]) Values() (A, B, C, D, E) {
+	return this.v1, this.v2, this.v3, this.v4, this.v5
+}
+
+// Slice tuple values as a slice
+func (this *T5[A, B, C, D, E]) Slice() []any {
+	return []any{this.v1, this.v2, this.v3, this.v4, this.v5}
+}
+
+func (this *T5[A, B, C, D, E]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v, %v)", this.v1, this.v2, this.v3, this.v4, this.v5)
+}
+
+// MarshalJSON tuple as a JSON array
+func (this T5[A, B, C, D, E]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(this.Slice())
+}
+
+// UnmarshalJSON tuple from a JSON array of 5 values
+func (this *T5[A, B, C, D, E]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems(data, 5)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[0], &this.v1); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &this.v2); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[2], &this.v3); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[3], &this.v4); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[4], &this.v5); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tupled5 function of 5 args as a function of T5
+func Tupled5[A, B, C, D, E, T any](f func(A, B, C, D, E) T) func(*T5[A, B, C, D, E]) T {
+	return func(t *T5[A, B, C, D, E]) T {
+		return f(t.Values())
+	}
+}
+
+// T6 tuple of 6 values
+type T6[A, B, C, D, E, F any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+	v5 E
+	v6 F
+}
+
+func Of6[A, B, C, D, E, F any](a A, b B, c C, d D, e E, f F) *T6[A, B, C, D, E, F] {
+	return &T6[A, B, C, D, E, F]{v1: a, v2: b, v3: c, v4: d, v5: e, v6: f}
+}
+
+func (this *T6[A, B, C, D, E, F]) V1() A {
+	return this.v1
+}
+
+func (this *T6[A, B, C, D, E, F]) V2() B {
+	return this.v2
+}
+
+func (this *T6[A, B, C, D, E, F]) V3() C {
+	return this.v3
+}
+
+func (this *T6[A, B, C, D, E, F]) V4() D {
+	return this.v4
+}
+
+func (this *T6[A, B, C, D, E, F]) V5() E {
+	return this.v5
+}
+
+func (this *T6[A, B, C, D, E, F]) V6() F {
+	return this.v6
+}
+
+// Values tuple values
+func (this *T6[A, B, C, D, E, F]) Values() (A, B, C, D, E, F) {
+	return this.v1, this.v2, this.v3, this.v4, this.v5, this.v6
+}
+
+// Slice tuple values as a slice
+func (this *T6[A, B, C, D, E, F]) Slice() []any {
+	return []any{this.v1, this.v2, this.v3, this.v4, this.v5, this.v6}
+}
+
+func (this *T6[A, B, C, D, E, F]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v, %v, %v)", this.v1, this.v2, this.v3, this.v4, this.v5, this.v6)
+}
+
+// MarshalJSON tuple as a JSON array
+func (this T6[A, B, C, D, E, F]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(this.Slice())
+}
+
+// UnmarshalJSON tuple from a JSON array of 6 values
+func (this *T6[A, B, C, D, E, F]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems(data, 6)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[0], &this.v1); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &this.v2); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[2], &this.v3); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[3], &this.v4); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[4], &this.v5); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[5], &this.v6); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tupled6 function of 6 args as a function of T6
+func Tupled6[A, B, C, D, E, F, T any](f func(A, B, C, D, E, F) T) func(*T6[A, B, C, D, E, F]) T {
+	return func(t *T6[A, B, C, D, E, F]) T {
+		return f(t.Values())
+	}
+}
+
+// T7 tuple of 7 values
+type T7[A, B, C, D, E, F, G any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+	v5 E
+	v6 F
+	v7 G
+}
+
+func Of7[A, B, C, D, E, F, G any](a A, b B, c C, d D, e E, f F, g G) *T7[A, B, C, D, E, F, G] {
+	return &T7[A, B, C, D, E, F, G]{v1: a, v2: b, v3: c, v4: d, v5: e, v6: f, v7: g}
+}
+
+func (this *T7[A, B, C, D, E, F, G]) V1() A {
+	return this.v1
+}
+
+func (this *T7[A, B, C, D, E, F, G]) V2() B {
+	return this.v2
+}
+
+func (this *T7[A, B, C, D, E, F, G]) V3() C {
+	return this.v3
+}
+
+func (this *T7[A, B, C, D, E, F, G]) V4() D {
+	return this.v4
+}
+
+func (this *T7[A, B, C, D, E, F, G]) V5() E {
+	return this.v5
+}
+
+func (this *T7[A, B, C, D, E, F, G]) V6() F {
+	return this.v6
+}
+
+func (this *T7[A, B, C, D, E, F, G]) V7() G {
+	return this.v7
+}
+
+// Values tuple values
+func (this *T7[A, B, C, D, E, F, G]) Values() (A, B, C, D, E, F, G) {
+	return this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7
+}
+
+// Slice tuple values as a slice
+func (this *T7[A, B, C, D, E, F, G]) Slice() []any {
+	return []any{this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7}
+}
+
+func (this *T7[A, B, C, D, E, F, G]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v, %v, %v, %v)", this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7)
+}
+
+// MarshalJSON tuple as a JSON array
+func (this T7[A, B, C, D, E, F, G]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(this.Slice())
+}
+
+// UnmarshalJSON tuple from a JSON array of 7 values
+func (this *T7[A, B, C, D, E, F, G]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems(data, 7)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[0], &this.v1); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &this.v2); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[2], &this.v3); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[3], &this.v4); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[4], &this.v5); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[5], &this.v6); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[6], &this.v7); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tupled7 function of 7 args as a function of T7
+func Tupled7[A, B, C, D, E, F, G, T any](f func(A, B, C, D, E, F, G) T) func(*T7[A, B, C, D, E, F, G]) T {
+	return func(t *T7[A, B, C, D, E, F, G]) T {
+		return f(t.Values())
+	}
+}
+
+// T8 tuple of 8 values
+type T8[A, B, C, D, E, F, G, H any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+	v5 E
+	v6 F
+	v7 G
+	v8 H
+}
+
+func Of8[A, B, C, D, E, F, G, H any](a A, b B, c C, d D, e E, f F, g G, h H) *T8[A, B, C, D, E, F, G, H] {
+	return &T8[A, B, C, D, E, F, G, H]{v1: a, v2: b, v3: c, v4: d, v5: e, v6: f, v7: g, v8: h}
+}
+
+func (this *T8[A, B, C, D, E, F, G, H]) V1() A {
+	return this.v1
+}
+
+func (this *T8[A, B, C, D, E, F, G, H]) V2() B {
+	return this.v2
+}
+
+func (this *T8[A, B, C, D, E, F, G, H]) V3() C {
+	return this.v3
+}
+
+func (this *T8[A, B, C, D, E, F, G, H]) V4() D {
+	return this.v4
+}
+
+func (this *T8[A, B, C, D, E, F, G, H]) V5() E {
+	return this.v5
+}
+
+func (this *T8[A, B, C, D, E, F, G, H]) V6() F {
+	return this.v6
+}
+
+func (this *T8[A, B, C, D, E, F, G, H]) V7() G {
+	return this.v7
+}
+
+func (this *T8[A, B, C, D, E, F, G, H]) V8() H {
+	return this.v8
+}
+
+// Values tuple values
+func (this *T8[A, B, C, D, E, F, G, H]) Values() (A, B, C, D, E, F, G, H) {
+	return this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7, this.v8
+}
+
+// Slice tuple values as a slice
+func (this *T8[A, B, C, D, E, F, G, H]) Slice() []any {
+	return []any{this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7, this.v8}
+}
+
+func (this *T8[A, B, C, D, E, F, G, H]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v, %v, %v, %v, %v)", this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7, this.v8)
+}
+
+// MarshalJSON tuple as a JSON array
+func (this T8[A, B, C, D, E, F, G, H]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(this.Slice())
+}
+
+// UnmarshalJSON tuple from a JSON array of 8 values
+func (this *T8[A, B, C, D, E, F, G, H]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems(data, 8)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[0], &this.v1); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &this.v2); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[2], &this.v3); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[3], &this.v4); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[4], &this.v5); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[5], &this.v6); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[6], &this.v7); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[7], &this.v8); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tupled8 function of 8 args as a function of T8
+func Tupled8[A, B, C, D, E, F, G, H, T any](f func(A, B, C, D, E, F, G, H) T) func(*T8[A, B, C, D, E, F, G, H]) T {
+	return func(t *T8[A, B, C, D, E, F, G, H]) T {
+		return f(t.Values())
+	}
+}
+
+// T9 tuple of 9 values
+type T9[A, B, C, D, E, F, G, H, I any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+	v5 E
+	v6 F
+	v7 G
+	v8 H
+	v9 I
+}
+
+func Of9[A, B, C, D, E, F, G, H, I any](a A, b B, c C, d D, e E, f F, g G, h H, i I) *T9[A, B, C, D, E, F, G, H, I] {
+	return &T9[A, B, C, D, E, F, G, H, I]{v1: a, v2: b, v3: c, v4: d, v5: e, v6: f, v7: g, v8: h, v9: i}
+}
+
+func (this *T9[A, B, C, D, E, F, G, H, I]) V1() A {
+	return this.v1
+}
+
+func (this *T9[A, B, C, D, E, F, G, H, I]) V2() B {
+	return this.v2
+}
+
+func (this *T9[A, B, C, D, E, F, G, H, I]) V3() C {
+	return this.v3
+}
+
+func (this *T9[A, B, C, D, E, F, G, H, I]) V4() D {
+	return this.v4
+}
+
+func (this *T9[A, B, C, D, E, F, G, H, I]) V5() E {
+	return this.v5
+}
+
+func (this *T9[A, B, C, D, E, F, G, H, I]) V6() F {
+	return this.v6
+}
+
+func (this *T9[A, B, C, D, E, F, G, H, I]) V7() G {
+	return this.v7
+}
+
+func (this *T9[A, B, C, D, E, F, G, H, I]) V8() H {
+	return this.v8
+}
+
+func (this *T9[A, B, C, D, E, F, G, H, I]) V9() I {
+	return this.v9
+}
+
+// Values tuple values
+func (this *T9[A, B, C, D, E, F, G, H, I]) Values() (A, B, C, D, E, F, G, H, I) {
+	return this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7, this.v8, this.v9
+}
+
+// Slice tuple values as a slice
+func (this *T9[A, B, C, D, E, F, G, H, I]) Slice() []any {
+	return []any{this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7, this.v8, this.v9}
+}
+
+func (this *T9[A, B, C, D, E, F, G, H, I]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v, %v, %v, %v, %v, %v)", this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7, this.v8, this.v9)
+}
+
+// MarshalJSON tuple as a JSON array
+func (this T9[A, B, C, D, E, F, G, H, I]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(this.Slice())
+}
+
+// UnmarshalJSON tuple from a JSON array of 9 values
+func (this *T9[A, B, C, D, E, F, G, H, I]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems(data, 9)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[0], &this.v1); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &this.v2); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[2], &this.v3); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[3], &this.v4); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[4], &this.v5); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[5], &this.v6); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[6], &this.v7); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[7], &this.v8); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[8], &this.v9); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tupled9 function of 9 args as a function of T9
+func Tupled9[A, B, C, D, E, F, G, H, I, T any](f func(A, B, C, D, E, F, G, H, I) T) func(*T9[A, B, C, D, E, F, G, H, I]) T {
+	return func(t *T9[A, B, C, D, E, F, G, H, I]) T {
+		return f(t.Values())
+	}
+}
+
+// T10 tuple of 10 values
+type T10[A, B, C, D, E, F, G, H, I, J any] struct {
+	v1  A
+	v2  B
+	v3  C
+	v4  D
+	v5  E
+	v6  F
+	v7  G
+	v8  H
+	v9  I
+	v10 J
+}
+
+func Of10[A, B, C, D, E, F, G, H, I, J any](a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) *T10[A, B, C, D, E, F, G, H, I, J] {
+	return &T10[A, B, C, D, E, F, G, H, I, J]{v1: a, v2: b, v3: c, v4: d, v5: e, v6: f, v7: g, v8: h, v9: i, v10: j}
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) V1() A {
+	return this.v1
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) V2() B {
+	return this.v2
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) V3() C {
+	return this.v3
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) V4() D {
+	return this.v4
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) V5() E {
+	return this.v5
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) V6() F {
+	return this.v6
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) V7() G {
+	return this.v7
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) V8() H {
+	return this.v8
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) V9() I {
+	return this.v9
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) V10() J {
+	return this.v10
+}
+
+// Values tuple values
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) Values() (A, B, C, D, E, F, G, H, I, J) {
+	return this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7, this.v8, this.v9, this.v10
+}
+
+// Slice tuple values as a slice
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) Slice() []any {
+	return []any{this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7, this.v8, this.v9, this.v10}
+}
+
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v, %v, %v, %v, %v, %v, %v)", this.v1, this.v2, this.v3, this.v4, this.v5, this.v6, this.v7, this.v8, this.v9, this.v10)
+}
+
+// MarshalJSON tuple as a JSON array
+func (this T10[A, B, C, D, E, F, G, H, I, J]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(this.Slice())
+}
+
+// UnmarshalJSON tuple from a JSON array of 10 values
+func (this *T10[A, B, C, D, E, F, G, H, I, J]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems(data, 10)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[0], &this.v1); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &this.v2); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[2], &this.v3); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[3], &this.v4); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[4], &this.v5); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[5], &this.v6); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[6], &this.v7); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[7], &this.v8); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[8], &this.v9); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[9], &this.v10); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tupled10 function of 10 args as a function of T10
+func Tupled10[A, B, C, D, E, F, G, H, I, J, T any](f func(A, B, C, D, E, F, G, H, I, J) T) func(*T10[A, B, C, D, E, F, G, H, I, J]) T {
+	return func(t *T10[A, B, C, D, E, F, G, H, I, J]) T {
+		return f(t.Values())
+	}
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// IOStateReader effects that read state variables by type
+type IOStateReader interface {
+	StateReads() []reflect.Type
+}
+
+// IODescriber IOs that can describe your effects
+type IODescriber interface {
+	Describe() *IODescription
+}
+
+type EffectDescription struct {
+	Name     string
+	TypeIn   string
+	TypeOut  string
+	Filename string
+	Line     int
+	Reads    []string
+}
+
+// IODescription static description of IO effects and state variable types read
+type IODescription struct {
+	Name    string
+	Type    string
+	Effects []*EffectDescription
+	Reads   []string
+	Errors  []string
+}
+
+func DescribeEffect(eff IOEffect) *EffectDescription {
+	desc := &EffectDescription{
+		Name:    effectName(eff),
+		TypeIn:  eff.TypeIn().String(),
+		TypeOut: eff.TypeOut().String(),
+	}
+	if info := eff.GetDebugInfo(); info != nil {
+		desc.Filename, desc.Line = info.Filename, info.Line
+	}
+	if reader, ok := eff.(IOStateReader); ok {
+		for _, typ := range reader.StateReads() {
+			desc.Reads = append(desc.Reads, typ.String())
+		}
+	}
+	return desc
+}
+
+// Describe effects in execution order. Errors are the type flow errors, if any
+func (this *IO[T]) Describe() *IODescription {
+	desc := &IODescription{
+		Name: this.varName,
+		Type: reflect.TypeFor[T]().String(),
+	}
+	for _, eff := range this.stack.GetItems() {
+		effDesc := DescribeEffect(eff)
+		desc.Effects = append(desc.Effects, effDesc)
+		desc.Reads = append(desc.Reads, effDesc.Reads...)
+	}
+	for _, err := range this.ValidateFlow() {
+		desc.Errors = append(desc.Errors, err.Error())
+	}
+	return desc
+}
+
+func (this *EffectDescription) String() string {
+	if this.Filename == "" {
+		return fmt.Sprintf("%v(%v => %v)", this.Name, this.TypeIn, this.TypeOut)
+	}
+	return fmt.Sprintf("%v(%v => %v) at %v:%v", this.Name, this.TypeIn, this.TypeOut, this.Filename, this.Line)
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mobilemindtech/go-io/types/unit"
+)
+
+// FlowError effect input type that does not match the previous effect output type
+type FlowError struct {
+	IO       string
+	Effect   string
+	Expected reflect.Type
+	Previous string
+	Actual   reflect.Type
+	Filename string
+	Line     int
+}
+
+// IOFlowValidator IOs that can collect all type flow errors
+type IOFlowValidator interface {
+	ValidateFlow() []FlowError
+}
+
+func (this FlowError) Error() string {
+	msg := fmt.Sprintf("IO %v expect type is %v, but last IO %v result type is %v",
+		this.Effect, this.Expected, this.Previous, this.Actual)
+	if this.Filename != "" {
+		msg = fmt.Sprintf("%v:%v: %v", this.Filename, this.Line, msg)
+	}
+	return msg
+}
+
+// FlowErrors all type flow errors of an IO or app
+type FlowErrors []FlowError
+
+func (this FlowErrors) Error() string {
+	msgs := make([]string, len(this))
+	for i, err := range this {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Validate check if each effect input type match the previous effect output
+// type. Effects that take unit don't depend on the previous output. All
+// mismatches are returned with the effect declaration site
+func Validate(effects ...IOEffect) []FlowError {
+
+	var errs []FlowError
+	var lastTypeOut reflect.Type
+	var lastIO string
+	unitType := reflect.TypeFor[*unit.Unit]()
+
+	for _, it := range effects {
+
+		if lastTypeOut == nil {
+			lastTypeOut = it.TypeOut()
+			lastIO = effectName(it)
+			continue
+		}
+
+		if it.TypeIn() == unitType {
+			if it.TypeOut() != unitType {
+				lastTypeOut = it.TypeOut()
+			}
+			continue
+		}
+
+		if lastTypeOut != it.TypeIn() {
+			err := FlowError{
+				Effect:   effectName(it),
+				Expected: it.TypeIn(),
+				Previous: lastIO,
+				Actual:   lastTypeOut,
+			}
+			if info := it.GetDebugInfo(); info != nil {
+				err.Filename, err.Line = info.Filename, info.Line
+			}
+			errs = append(errs, err)
+		}
+
+		lastTypeOut = it.TypeOut()
+		lastIO = effectName(it)
+	}
+	return errs
+}
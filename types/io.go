@@ -3,14 +3,17 @@ package types
 import (
 	"fmt"
 	"github.com/mobilemindtech/go-io/collections"
+	"github.com/mobilemindtech/go-io/logging"
 	"github.com/mobilemindtech/go-io/option"
 	"github.com/mobilemindtech/go-io/result"
 	"github.com/mobilemindtech/go-io/state"
 	"github.com/mobilemindtech/go-io/types/unit"
 	"github.com/mobilemindtech/go-io/util"
-	"log"
+	"log/slog"
 	"reflect"
 	"runtime"
+	"slices"
+	"strings"
 )
 
 type IOUnit = *IO[*unit.Unit]
@@ -22,6 +25,8 @@ type IO[T any] struct {
 	debug      bool
 	prevEffect IOEffect
 	lastEffect IOEffect
+	logger     *slog.Logger // set by WithLogger
+	runLogger  *slog.Logger // set by the runtime with SetLogger
 	//suspendedIOs []IORunnable
 }
 
@@ -77,13 +82,42 @@ func (this *IO[T]) As(name string) *IO[T] {
 	return this
 }
 
+// Effects push effects. Effects without debug info get the caller outside
+// library constructors, like io.IO, as declaration site
 func (this *IO[T]) Effects(vals ...IOEffect) *IO[T] {
+	filename, line := callerOutside()
 	for _, eff := range vals {
+		if eff.GetDebugInfo() == nil {
+			eff.SetDebugInfo(&IODebugInfo{Line: line, Filename: filename})
+		}
 		this.push(eff)
 	}
 	return this
 }
 
+var constructorPackages = []string{
+	"github.com/mobilemindtech/go-io/types.",
+	"github.com/mobilemindtech/go-io/io.",
+}
+
+// callerOutside first caller that is not an IO constructor
+func callerOutside() (string, int) {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !slices.ContainsFunc(constructorPackages, func(pkg string) bool {
+			return strings.HasPrefix(frame.Function, pkg)
+		}) {
+			return frame.File, frame.Line
+		}
+		if !more {
+			return "", 0
+		}
+	}
+}
+
 func (this *IO[T]) Pipe(val IOEffect) *IO[T] {
 	_, filename, line, _ := runtime.Caller(1)
 	val.SetDebugInfo(&IODebugInfo{Line: line, Filename: filename})
@@ -275,6 +309,13 @@ func (this *IO[T]) FailIf(val IOEffect) *IO[T] {
 	return this
 }
 
+func (this *IO[T]) Validate(val IOEffect) *IO[T] {
+	_, filename, line, _ := runtime.Caller(1)
+	val.SetDebugInfo(&IODebugInfo{Line: line, Filename: filename})
+	this.push(val)
+	return this
+}
+
 func (this *IO[T]) Foreach(val IOEffect) *IO[T] {
 	_, filename, line, _ := runtime.Caller(1)
 	val.SetDebugInfo(&IODebugInfo{Line: line, Filename: filename})
@@ -295,24 +336,26 @@ func (this *IO[T]) runStackIO(currEff IOEffect, sp int) IOEffect {
 		this.runStackIO(eff, sp-1)
 	}
 
-	if this.debug {
-		log.Printf("IO>> UnsafeRun IO(Name=%v,SP=%v) %v",
-			this.varName, sp, reflect.TypeOf(currEff))
-	}
-
 	if stf, ok := currEff.(IOStateful); ok {
 		stf.SetState(this.state)
 	}
 
+	if lg, ok := currEff.(IOLoggable); ok && this.GetLogger() != nil {
+		lg.SetLogger(this.GetLogger())
+	}
+
 	if this.debug {
 		currEff.SetDebug(this.debug)
 	}
 
+	span := startEffectSpan(currEff, this.varName)
+	defer recoverEffectSpan(span)
 	r := currEff.UnsafeRun()
+	endEffectSpan(span, r)
 
-	//if this.debug {
-	//	log.Printf("IO>> UnsafeRun IO(%v) before %v = %v", this.varName,reflect.TypeOf(currEff).Name(), r.String())
-	//}
+	if this.debug {
+		this.effectLog(currEff).Info("effect run", "sp", sp, "result", r.String())
+	}
 
 	return r
 }
@@ -320,7 +363,7 @@ func (this *IO[T]) runStackIO(currEff IOEffect, sp int) IOEffect {
 func (this *IO[T]) UnsafeRun() *result.Result[*option.Option[T]] {
 
 	if this.debug {
-		log.Printf("IO>> run stack IO(%v) with %v operations, prevEffect = %v", this.varName, this.stack.Count(), this.prevEffect)
+		this.log().Info("run stack", "operations", this.stack.Count(), "prev_effect", this.prevEffect)
 	}
 
 	// last to execute
@@ -355,37 +398,20 @@ func (this *IO[T]) UnsafeRun() *result.Result[*option.Option[T]] {
 	panic(fmt.Sprintf("can't cast %v to IO(%v) result type %v", r.GetValue(), this.varName, typOf))
 }
 
+// CheckTypesFlow panic with the first type flow error
 func (this *IO[T]) CheckTypesFlow() {
+	if errs := this.ValidateFlow(); len(errs) > 0 {
+		panic(errs[0])
+	}
+}
 
-	var lastTypeOut reflect.Type
-	var lastIO reflect.Type
-
-	for _, it := range this.stack.GetItems() {
-
-		if lastTypeOut == nil {
-			lastTypeOut = it.TypeOut()
-			lastIO = reflect.TypeOf(it).Elem()
-		} else {
-
-			if it.TypeIn() == reflect.TypeFor[*unit.Unit]() {
-				if it.TypeOut() != reflect.TypeFor[*unit.Unit]() {
-					lastTypeOut = it.TypeOut()
-				}
-				continue
-			}
-
-			if lastTypeOut != it.TypeIn() {
-
-				curr := reflect.TypeOf(it).Elem()
-
-				panic(fmt.Errorf("IO %v expect type is %v, but last IO %v result type is %v",
-					curr.Name(), it.TypeIn(), lastIO.Name(), lastTypeOut))
-			}
-
-			lastTypeOut = it.TypeOut()
-			lastIO = reflect.TypeOf(it).Elem()
-		}
+// ValidateFlow collect all type flow errors, with var name
+func (this *IO[T]) ValidateFlow() []FlowError {
+	errs := Validate(this.stack.GetItems()...)
+	for i := range errs {
+		errs[i].IO = this.varName
 	}
+	return errs
 }
 
 func (this *IO[T]) SetState(st *state.State) {
@@ -396,6 +422,38 @@ func (this *IO[T]) SetDebug(b bool) {
 	this.debug = b
 }
 
+// SetLogger set runtime logger. IOApp set your logger with run id. It is
+// used when the IO has not a logger set with WithLogger
+func (this *IO[T]) SetLogger(logger *slog.Logger) {
+	this.runLogger = logger
+}
+
+// GetLogger logger set with WithLogger, or runtime logger
+func (this *IO[T]) GetLogger() *slog.Logger {
+	if this.logger != nil {
+		return this.logger
+	}
+	return this.runLogger
+}
+
+func (this *IO[T]) WithLogger(logger *slog.Logger) *IO[T] {
+	this.logger = logger
+	return this
+}
+
+func (this *IO[T]) log() *slog.Logger {
+	return logging.Or(this.GetLogger()).With(logging.KeyIO, this.varName)
+}
+
+// effectLog logger with effect type and caller
+func (this *IO[T]) effectLog(eff IOEffect) *slog.Logger {
+	logger := this.log().With(logging.KeyEffect, effectName(eff))
+	if info := eff.GetDebugInfo(); info != nil {
+		logger = logger.With(logging.Caller(info.Filename, info.Line))
+	}
+	return logger
+}
+
 func (this *IO[T]) DebugOn() *IO[T] {
 	this.SetDebug(true)
 	return this
@@ -1,6 +1,9 @@
 package types
 
-import "github.com/mobilemindtech/go-io/result"
+import (
+	"github.com/mobilemindtech/go-io/result"
+	"reflect"
+)
 
 type IResourceIO interface {
 	GetVarName() string
@@ -23,3 +26,7 @@ func (this *ResourceIO[T]) Open() *result.Result[any] {
 func (this *ResourceIO[T]) Close() *result.Result[any] {
 	return this.CloseFn().ToResultOf()
 }
+
+func (this *ResourceIO[T]) IOType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
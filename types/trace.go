@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mobilemindtech/go-io/trace"
+	"github.com/mobilemindtech/go-io/util"
+)
+
+// effectName effect type name without type params
+func effectName(eff IOEffect) string {
+	typ := reflect.TypeOf(eff)
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	name, _, _ := strings.Cut(typ.Name(), "[")
+	return name
+}
+
+// startEffectSpan root span of effect with your IODebugInfo, if tracing is enabled
+func startEffectSpan(eff IOEffect, varName string) trace.Span {
+	if !trace.Enabled() {
+		return nil
+	}
+	span := trace.Start(nil, effectName(eff))
+	span.SetAttribute(trace.AttrIOType, eff.TypeOut().String())
+	if varName != "" {
+		span.SetAttribute(trace.AttrIOName, varName)
+	}
+	if info := eff.GetDebugInfo(); info != nil {
+		span.SetAttribute(trace.AttrFilepath, info.Filename)
+		span.SetAttribute(trace.AttrLineno, info.Line)
+	}
+	return span
+}
+
+func endEffectSpan(span trace.Span, eff IOEffect) {
+	if span == nil {
+		return
+	}
+	r := eff.GetResult()
+	if r.IsError() {
+		span.RecordError(r.GetError())
+	} else {
+		span.SetAttribute(trace.AttrEmpty, util.IsNil(r.GetValue()) || r.Get().Empty())
+	}
+	span.End()
+}
+
+// recoverEffectSpan end span of effect that panic
+func recoverEffectSpan(span trace.Span) {
+	if span == nil {
+		return
+	}
+	if err := recover(); err != nil {
+		span.RecordError(fmt.Errorf("%v", err))
+		span.End()
+		panic(err)
+	}
+}
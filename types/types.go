@@ -4,6 +4,7 @@ import (
 	"github.com/mobilemindtech/go-io/option"
 	"github.com/mobilemindtech/go-io/result"
 	"github.com/mobilemindtech/go-io/state"
+	"log/slog"
 	"reflect"
 )
 
@@ -31,6 +32,13 @@ type IOStateful interface {
 	SetState(*state.State)
 }
 
+// IOLoggable IOs and effects that accept a runtime logger. GetLogger is nil
+// when no logger is set
+type IOLoggable interface {
+	SetLogger(*slog.Logger)
+	GetLogger() *slog.Logger
+}
+
 type IOLift[T any] interface {
 	Lift() *IO[T]
 }
@@ -0,0 +1,139 @@
+package validation
+
+import (
+	"cmp"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/mobilemindtech/go-io/option"
+)
+
+// Rule return a error message when value is invalid
+type Rule[T any] func(T) *option.Option[string]
+
+// Field validate value with all rules, accumulating messages on path
+func Field[T any](path string, value T, rules ...Rule[T]) *V[T] {
+	var errs []*FieldError
+	for _, rule := range rules {
+		if msg := rule(value); msg.NonEmpty() {
+			errs = append(errs, NewFieldError(path, msg.Get()))
+		}
+	}
+	if len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(value)
+}
+
+// Optional apply rules only when value is not zero
+func Optional[T any](rules ...Rule[T]) Rule[T] {
+	return func(value T) *option.Option[string] {
+		if isZero(value) {
+			return option.None[string]()
+		}
+		for _, rule := range rules {
+			if msg := rule(value); msg.NonEmpty() {
+				return msg
+			}
+		}
+		return option.None[string]()
+	}
+}
+
+// Required value should not be zero
+func Required[T any]() Rule[T] {
+	return func(value T) *option.Option[string] {
+		if isZero(value) {
+			return option.Some("is required")
+		}
+		return option.None[string]()
+	}
+}
+
+// MinLen string should have at least n characters
+func MinLen(n int) Rule[string] {
+	return func(value string) *option.Option[string] {
+		if utf8.RuneCountInString(value) < n {
+			return option.Some(fmt.Sprintf("must have at least %v characters", n))
+		}
+		return option.None[string]()
+	}
+}
+
+// MaxLen string should have at most n characters
+func MaxLen(n int) Rule[string] {
+	return func(value string) *option.Option[string] {
+		if utf8.RuneCountInString(value) > n {
+			return option.Some(fmt.Sprintf("must have at most %v characters", n))
+		}
+		return option.None[string]()
+	}
+}
+
+// Regex string should match pattern. Panic if pattern is invalid
+func Regex(pattern string) Rule[string] {
+	re := regexp.MustCompile(pattern)
+	return func(value string) *option.Option[string] {
+		if !re.MatchString(value) {
+			return option.Some(fmt.Sprintf("must match %v", pattern))
+		}
+		return option.None[string]()
+	}
+}
+
+// Range value should be between min and max, inclusive
+func Range[T cmp.Ordered](min T, max T) Rule[T] {
+	return func(value T) *option.Option[string] {
+		if value < min || value > max {
+			return option.Some(fmt.Sprintf("must be between %v and %v", min, max))
+		}
+		return option.None[string]()
+	}
+}
+
+// Email string should be a email address without name
+func Email() Rule[string] {
+	return func(value string) *option.Option[string] {
+		addr, err := mail.ParseAddress(value)
+		if err != nil || addr.Address != value {
+			return option.Some("must be a valid email")
+		}
+		return option.None[string]()
+	}
+}
+
+// OneOf value should be one of values
+func OneOf[T comparable](values ...T) Rule[T] {
+	return func(value T) *option.Option[string] {
+		if !slices.Contains(values, value) {
+			return option.Some(fmt.Sprintf("must be one of %v", values))
+		}
+		return option.None[string]()
+	}
+}
+
+// Check value with predicate, or return message
+func Check[T any](f func(T) bool, message string) Rule[T] {
+	return func(value T) *option.Option[string] {
+		if !f(value) {
+			return option.Some(message)
+		}
+		return option.None[string]()
+	}
+}
+
+func isZero(value any) bool {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || v.IsZero() {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return false
+}
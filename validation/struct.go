@@ -0,0 +1,190 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mobilemindtech/go-io/option"
+)
+
+// Struct validate struct fields with validate tag. Field paths use json tag names.
+// Nested structs, pointers and slices of structs are validated too.
+//
+// Tag rules: required, min=N, max=N (length of strings and slices or number value),
+// email, oneof=a b c and regex=pattern. regex should be the last rule. Unknown or
+// invalid rules are returned as field errors. Cyclic pointers are validated once.
+//
+//	type User struct {
+//		Name  string `json:"name" validate:"required,min=3"`
+//		Email string `json:"email" validate:"required,email"`
+//	}
+func Struct[T any](value T) *V[T] {
+	var errs []*FieldError
+	validateValue(reflect.ValueOf(value), "", &errs, map[visit]bool{})
+	if len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(value)
+}
+
+// visit is a pointer being validated, used to stop on cyclic values
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func validateValue(v reflect.Value, path string, errs *[]*FieldError, visited map[visit]bool) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		if v.Kind() == reflect.Pointer {
+			key := visit{v.Pointer(), v.Type()}
+			if visited[key] {
+				return
+			}
+			visited[key] = true
+			defer delete(visited, key)
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldPath := joinPath(path, fieldName(field))
+			fieldValue := v.Field(i)
+			if tag, ok := field.Tag.Lookup("validate"); ok {
+				for _, msg := range validateTag(fieldValue, tag) {
+					*errs = append(*errs, NewFieldError(fieldPath, msg))
+				}
+			}
+			validateValue(fieldValue, fieldPath, errs, visited)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			validateValue(v.Index(i), joinPath(path, fmt.Sprintf("[%v]", i)), errs, visited)
+		}
+	}
+}
+
+func fieldName(field reflect.StructField) string {
+	if tag, ok := field.Tag.Lookup("json"); ok {
+		name, _, _ := strings.Cut(tag, ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+func validateTag(v reflect.Value, tag string) []string {
+	var messages []string
+	add := func(msg *option.Option[string]) {
+		if msg.NonEmpty() {
+			messages = append(messages, msg.Get())
+		}
+	}
+
+	for tag != "" {
+		var rule string
+		if strings.HasPrefix(tag, "regex=") {
+			rule, tag = tag, ""
+		} else {
+			rule, tag, _ = strings.Cut(tag, ",")
+		}
+		name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+
+		switch name {
+		case "":
+		case "required":
+			if isZero(v.Interface()) {
+				add(option.Some("is required"))
+			}
+		case "min", "max":
+			add(checkBound(v, name, arg))
+		case "email":
+			if s, ok := stringValue(v); ok && s != "" {
+				add(Email()(s))
+			}
+		case "oneof":
+			if s, ok := stringValue(v); ok && s != "" {
+				add(OneOf(strings.Fields(arg)...)(s))
+			}
+		case "regex":
+			if s, ok := stringValue(v); ok && s != "" {
+				add(Regex(arg)(s))
+			}
+		default:
+			add(option.Some(fmt.Sprintf("unknown validation rule %v", name)))
+		}
+	}
+	return messages
+}
+
+func checkBound(v reflect.Value, name string, arg string) *option.Option[string] {
+	bound, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return option.Some(fmt.Sprintf("invalid %v validation value %v", name, arg))
+	}
+
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return option.None[string]()
+		}
+		v = v.Elem()
+	}
+
+	var value float64
+	unit := ""
+	switch v.Kind() {
+	case reflect.String:
+		if v.String() == "" {
+			return option.None[string]()
+		}
+		value, unit = float64(utf8.RuneCountInString(v.String())), "characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		value, unit = float64(v.Len()), "items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		value = v.Float()
+	default:
+		return option.Some(fmt.Sprintf("%v validation is not supported for %v", name, v.Type()))
+	}
+
+	switch {
+	case name == "min" && value < bound && unit != "":
+		return option.Some(fmt.Sprintf("must have at least %v %v", arg, unit))
+	case name == "max" && value > bound && unit != "":
+		return option.Some(fmt.Sprintf("must have at most %v %v", arg, unit))
+	case name == "min" && value < bound:
+		return option.Some(fmt.Sprintf("must be at least %v", arg))
+	case name == "max" && value > bound:
+		return option.Some(fmt.Sprintf("must be at most %v", arg))
+	}
+	return option.None[string]()
+}
+
+func stringValue(v reflect.Value) (string, bool) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.String {
+		return v.String(), true
+	}
+	return "", false
+}
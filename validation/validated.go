@@ -0,0 +1,171 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mobilemindtech/go-io/result"
+)
+
+// FieldError is a validation message of a field path, like address.street
+type FieldError struct {
+	Path    string
+	Message string
+}
+
+func NewFieldError(path string, message string) *FieldError {
+	return &FieldError{Path: path, Message: message}
+}
+
+func (this *FieldError) Error() string {
+	if this.Path == "" {
+		return this.Message
+	}
+	return fmt.Sprintf("%v: %v", this.Path, this.Message)
+}
+
+// V is a validated value or the accumulated field errors
+type V[T any] struct {
+	value  T
+	errors []*FieldError
+}
+
+func Valid[T any](value T) *V[T] {
+	return &V[T]{value: value}
+}
+
+func Invalid[T any](errs ...*FieldError) *V[T] {
+	if len(errs) == 0 {
+		panic("invalid should have errors")
+	}
+	return &V[T]{errors: errs}
+}
+
+func InvalidField[T any](path string, message string) *V[T] {
+	return Invalid[T](NewFieldError(path, message))
+}
+
+func (this *V[T]) IsValid() bool {
+	return len(this.errors) == 0
+}
+
+func (this *V[T]) IsInvalid() bool {
+	return !this.IsValid()
+}
+
+// Get valid value. Panic if invalid
+func (this *V[T]) Get() T {
+	if this.IsInvalid() {
+		panic(fmt.Sprintf("get value of invalid validation: %v", this.Failure()))
+	}
+	return this.value
+}
+
+func (this *V[T]) Errors() []*FieldError {
+	return this.errors
+}
+
+// At nest field errors paths under path
+func (this *V[T]) At(path string) *V[T] {
+	if this.IsValid() || path == "" {
+		return this
+	}
+	errs := make([]*FieldError, len(this.errors))
+	for i, err := range this.errors {
+		errs[i] = NewFieldError(joinPath(path, err.Path), err.Message)
+	}
+	return &V[T]{errors: errs}
+}
+
+// Failure convert errors to Failure. Messages of same path are joined
+func (this *V[T]) Failure() *Failure {
+	failure := &Failure{Errors: map[string]string{}, Fields: this.errors}
+	for _, err := range this.errors {
+		if msg, ok := failure.Errors[err.Path]; ok {
+			failure.Errors[err.Path] = msg + "; " + err.Message
+		} else {
+			failure.Errors[err.Path] = err.Message
+		}
+	}
+	return failure
+}
+
+// ToValidation convert to Validation
+func (this *V[T]) ToValidation() Validation {
+	if this.IsValid() {
+		return NewSuccess()
+	}
+	return this.Failure()
+}
+
+// ToResult convert to result, with *Failure as error
+func (this *V[T]) ToResult() *result.Result[T] {
+	if this.IsValid() {
+		return result.OfValue(this.value)
+	}
+	return result.OfError[T](this.Failure())
+}
+
+func (this *V[T]) String() string {
+	if this.IsValid() {
+		return fmt.Sprintf("Valid(%v)", this.value)
+	}
+	return fmt.Sprintf("Invalid(%v)", this.Failure())
+}
+
+// Map valid value
+func Map[A, B any](v *V[A], f func(A) B) *V[B] {
+	if v.IsInvalid() {
+		return &V[B]{errors: v.errors}
+	}
+	return Valid(f(v.value))
+}
+
+// FlatMap valid value. Errors are not accumulated, use MapN for that
+func FlatMap[A, B any](v *V[A], f func(A) *V[B]) *V[B] {
+	if v.IsInvalid() {
+		return &V[B]{errors: v.errors}
+	}
+	return f(v.value)
+}
+
+// Sequence accumulate errors of all validations
+func Sequence[T any](vs []*V[T]) *V[[]T] {
+	var errs []*FieldError
+	for _, v := range vs {
+		errs = append(errs, v.errors...)
+	}
+	if len(errs) > 0 {
+		return Invalid[[]T](errs...)
+	}
+	values := make([]T, len(vs))
+	for i, v := range vs {
+		values[i] = v.value
+	}
+	return Valid(values)
+}
+
+type errorsOf interface {
+	Errors() []*FieldError
+}
+
+func collectErrors(vs ...errorsOf) []*FieldError {
+	var errs []*FieldError
+	for _, v := range vs {
+		errs = append(errs, v.Errors()...)
+	}
+	return errs
+}
+
+func joinPath(parent string, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	default:
+		return parent + "." + child
+	}
+}
@@ -0,0 +1,75 @@
+// Code generated by go-io/internal/gen. DO NOT EDIT.
+
+package validation
+
+// Map2 accumulate errors of validations or map valid values
+func Map2[A, B, T any](a *V[A], b *V[B], fn func(A, B) T) *V[T] {
+	if errs := collectErrors(a, b); len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(fn(a.value, b.value))
+}
+
+// Map3 accumulate errors of validations or map valid values
+func Map3[A, B, C, T any](a *V[A], b *V[B], c *V[C], fn func(A, B, C) T) *V[T] {
+	if errs := collectErrors(a, b, c); len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(fn(a.value, b.value, c.value))
+}
+
+// Map4 accumulate errors of validations or map valid values
+func Map4[A, B, C, D, T any](a *V[A], b *V[B], c *V[C], d *V[D], fn func(A, B, C, D) T) *V[T] {
+	if errs := collectErrors(a, b, c, d); len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value))
+}
+
+// Map5 accumulate errors of validations or map valid values
+func Map5[A, B, C, D, E, T any](a *V[A], b *V[B], c *V[C], d *V[D], e *V[E], fn func(A, B, C, D, E) T) *V[T] {
+	if errs := collectErrors(a, b, c, d, e); len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value))
+}
+
+// Map6 accumulate errors of validations or map valid values
+func Map6[A, B, C, D, E, F, T any](a *V[A], b *V[B], c *V[C], d *V[D], e *V[E], f *V[F], fn func(A, B, C, D, E, F) T) *V[T] {
+	if errs := collectErrors(a, b, c, d, e, f); len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value))
+}
+
+// Map7 accumulate errors of validations or map valid values
+func Map7[A, B, C, D, E, F, G, T any](a *V[A], b *V[B], c *V[C], d *V[D], e *V[E], f *V[F], g *V[G], fn func(A, B, C, D, E, F, G) T) *V[T] {
+	if errs := collectErrors(a, b, c, d, e, f, g); len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value, g.value))
+}
+
+// Map8 accumulate errors of validations or map valid values
+func Map8[A, B, C, D, E, F, G, H, T any](a *V[A], b *V[B], c *V[C], d *V[D], e *V[E], f *V[F], g *V[G], h *V[H], fn func(A, B, C, D, E, F, G, H) T) *V[T] {
+	if errs := collectErrors(a, b, c, d, e, f, g, h); len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value, g.value, h.value))
+}
+
+// Map9 accumulate errors of validations or map valid values
+func Map9[A, B, C, D, E, F, G, H, I, T any](a *V[A], b *V[B], c *V[C], d *V[D], e *V[E], f *V[F], g *V[G], h *V[H], i *V[I], fn func(A, B, C, D, E, F, G, H, I) T) *V[T] {
+	if errs := collectErrors(a, b, c, d, e, f, g, h, i); len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value, g.value, h.value, i.value))
+}
+
+// Map10 accumulate errors of validations or map valid values
+func Map10[A, B, C, D, E, F, G, H, I, J, T any](a *V[A], b *V[B], c *V[C], d *V[D], e *V[E], f *V[F], g *V[G], h *V[H], i *V[I], j *V[J], fn func(A, B, C, D, E, F, G, H, I, J) T) *V[T] {
+	if errs := collectErrors(a, b, c, d, e, f, g, h, i, j); len(errs) > 0 {
+		return Invalid[T](errs...)
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value, g.value, h.value, i.value, j.value))
+}
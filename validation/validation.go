@@ -1,8 +1,11 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/mobilemindtech/go-io/option"
 )
 
 type Validation interface {
@@ -32,6 +35,8 @@ func (this *Success) GetErrors() map[string]string {
 
 type Failure struct {
 	Errors map[string]string
+	// Fields are all field errors, when created from V
+	Fields []*FieldError
 }
 
 func WithErrors(errs map[string]string) Validation {
@@ -61,3 +66,12 @@ func (this *Failure) Error() string {
 	}
 	return strings.Join(items, ", ")
 }
+
+// AsFailure find a *Failure in error chain
+func AsFailure(err error) *option.Option[*Failure] {
+	var failure *Failure
+	if errors.As(err, &failure) {
+		return option.Some(failure)
+	}
+	return option.None[*Failure]()
+}